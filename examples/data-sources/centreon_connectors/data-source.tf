@@ -0,0 +1,14 @@
+# Look up a connector by name
+data "centreon_connectors" "ssh" {
+  limit = 1
+  page  = 1
+
+  search = {
+    name  = "name"
+    value = "SSH Connector"
+  }
+}
+
+output "ssh_connector_id" {
+  value = data.centreon_connectors.ssh.connectors[0].id
+}
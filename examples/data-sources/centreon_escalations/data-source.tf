@@ -0,0 +1,13 @@
+# Audit the existing escalations
+data "centreon_escalations" "all" {
+  limit = 100
+  page  = 1
+}
+
+output "escalations" {
+  value = [for e in data.centreon_escalations.all.escalations : {
+    name               = e.name
+    first_notification = e.first_notification
+    contact_group_ids  = e.contact_group_ids
+  }]
+}
@@ -0,0 +1,19 @@
+# Get all host categories
+data "centreon_host_categories" "all" {
+  limit = 50
+  page  = 1
+}
+
+# Search for a specific host category
+data "centreon_host_categories" "production" {
+  limit = 10
+  page  = 1
+  search = {
+    name  = "name"
+    value = "production"
+  }
+}
+
+output "category_ids" {
+  value = { for c in data.centreon_host_categories.all.categories : c.name => c.id }
+}
@@ -0,0 +1,13 @@
+# Get all host severities
+data "centreon_host_severities" "all" {
+  limit = 50
+  page  = 1
+}
+
+# Output severities ordered by level
+output "severities" {
+  value = [for s in data.centreon_host_severities.all.severities : {
+    name  = s.name
+    level = s.level
+  }]
+}
@@ -0,0 +1,11 @@
+# Assert that a new host is UP
+check "web_is_up" {
+  data "centreon_host_status" "web" {
+    host_id = centreon_host.web.id
+  }
+
+  assert {
+    condition     = data.centreon_host_status.web.state == "UP"
+    error_message = "Host is ${data.centreon_host_status.web.state}: ${data.centreon_host_status.web.output}"
+  }
+}
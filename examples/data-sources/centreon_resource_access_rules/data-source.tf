@@ -0,0 +1,9 @@
+# Audit the existing resource access rules
+data "centreon_resource_access_rules" "all" {
+  limit = 100
+  page  = 1
+}
+
+output "disabled_rules" {
+  value = [for r in data.centreon_resource_access_rules.all.rules : r.name if !r.is_enabled]
+}
@@ -0,0 +1,13 @@
+# Search for a specific service category
+data "centreon_service_categories" "payments" {
+  limit = 10
+  page  = 1
+  search = {
+    name  = "name"
+    value = "payments"
+  }
+}
+
+output "payments_category" {
+  value = data.centreon_service_categories.payments.categories
+}
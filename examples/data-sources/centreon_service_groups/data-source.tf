@@ -0,0 +1,9 @@
+# Get all service groups
+data "centreon_service_groups" "all" {
+  limit = 50
+  page  = 1
+}
+
+output "service_group_ids" {
+  value = { for g in data.centreon_service_groups.all.groups : g.name => g.id }
+}
@@ -0,0 +1,9 @@
+# Get all service severities
+data "centreon_service_severities" "all" {
+  limit = 50
+  page  = 1
+}
+
+output "severity_levels" {
+  value = { for s in data.centreon_service_severities.all.severities : s.name => s.level }
+}
@@ -0,0 +1,9 @@
+# Get the status of a service
+data "centreon_service_status" "http" {
+  host_id    = centreon_host.web.id
+  service_id = 345
+}
+
+output "http_state" {
+  value = data.centreon_service_status.http.state
+}
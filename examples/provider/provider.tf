@@ -5,4 +5,5 @@ provider "centreon" {
   api_version                       = "latest"
   api_key                           = "YOUR_API_KEY"
   generate_and_reload_configuration = true
+  validate_references               = true
 }
@@ -0,0 +1,2 @@
+# Acknowledgements are imported by ID
+terraform import centreon_acknowledgement.known_issue 17
@@ -0,0 +1,6 @@
+resource "centreon_acknowledgement" "known_issue" {
+  host_id         = centreon_host.db.id
+  service_id      = 345
+  comment         = "Disk replacement scheduled, see ticket OPS-1234"
+  notify_contacts = true
+}
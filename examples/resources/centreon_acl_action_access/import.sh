@@ -0,0 +1,2 @@
+# ACL action accesses are imported by ID
+terraform import centreon_acl_action_access.operators 2
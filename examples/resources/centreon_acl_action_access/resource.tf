@@ -0,0 +1,12 @@
+resource "centreon_acl_action_access" "operators" {
+  name        = "Operators"
+  description = "Acknowledge problems and schedule downtimes"
+  actions = [
+    "host_acknowledgement",
+    "host_disacknowledgement",
+    "host_schedule_downtime",
+    "service_acknowledgement",
+    "service_disacknowledgement",
+    "service_schedule_downtime",
+  ]
+}
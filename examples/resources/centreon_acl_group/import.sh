@@ -0,0 +1,2 @@
+# ACL groups are imported by ID
+terraform import centreon_acl_group.network_operators 5
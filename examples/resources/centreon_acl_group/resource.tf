@@ -0,0 +1,7 @@
+resource "centreon_acl_group" "network_operators" {
+  name                = "network-operators"
+  alias               = "Network operators"
+  contact_group_ids   = [4]
+  resource_access_ids = [centreon_acl_resource_access.network.id]
+  action_access_ids   = [centreon_acl_action_access.operators.id]
+}
@@ -0,0 +1,2 @@
+# ACL resource accesses are imported by ID
+terraform import centreon_acl_resource_access.network 3
@@ -0,0 +1,7 @@
+resource "centreon_acl_resource_access" "network" {
+  name              = "Network devices"
+  description       = "Network devices, except the lab routers"
+  host_group_ids    = [12, 13]
+  excluded_host_ids = [101, 102]
+  service_group_ids = [7]
+}
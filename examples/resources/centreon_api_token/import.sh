@@ -0,0 +1,3 @@
+# API tokens are imported by user ID and token name. The token value is only
+# returned on creation, so imported tokens have no value
+terraform import centreon_api_token.network_team 21/terraform
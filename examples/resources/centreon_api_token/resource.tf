@@ -0,0 +1,34 @@
+# Tokens are revoked on destroy. Deleting them instead frees their name, so
+# that changing the expiration date replaces the token under the same name
+resource "centreon_api_token" "network_team" {
+  name              = "terraform"
+  user_id           = centreon_user.network_team.id
+  expiration_date   = "2027-12-31T23:59:59Z"
+  delete_on_destroy = true
+}
+
+# Manage the network team's objects with their own account
+provider "centreon" {
+  alias       = "network_team"
+  protocol    = "https"
+  server      = "centreon.acme.lan"
+  port        = "443"
+  api_version = "latest"
+  api_key     = centreon_api_token.network_team.token
+}
+
+# Token names are unique for a user: to rotate a token without downtime with
+# create_before_destroy, derive its name from the attributes that replace it
+resource "centreon_api_token" "ci" {
+  name            = "ci-${formatdate("YYYYMMDD", local.ci_token_expiration)}"
+  user_id         = centreon_user.network_team.id
+  expiration_date = local.ci_token_expiration
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+locals {
+  ci_token_expiration = "2027-06-30T23:59:59Z"
+}
@@ -0,0 +1,2 @@
+# Connectors are imported by ID
+terraform import centreon_connector.perl 1
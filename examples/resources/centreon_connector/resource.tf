@@ -0,0 +1,6 @@
+resource "centreon_connector" "perl" {
+  name         = "Perl Connector"
+  description  = "Runs the Perl plugins in a persistent interpreter"
+  command_line = "$CONNECTORS$/centreon_connector_perl --log-file=/var/log/centreon-engine/connector-perl.log"
+  command_ids  = [12, 13]
+}
@@ -0,0 +1,2 @@
+# Downtimes are imported by ID
+terraform import centreon_downtime.migration 42
@@ -0,0 +1,19 @@
+# Downtime of a host and its services during a migration
+resource "centreon_downtime" "migration" {
+  host_id       = centreon_host.db.id
+  start_time    = "2024-06-01T22:00:00+02:00"
+  end_time      = "2024-06-02T02:00:00+02:00"
+  comment       = "Database migration"
+  with_services = true
+}
+
+# Flexible downtime of a single service, lasting one hour from its first problem
+resource "centreon_downtime" "backup" {
+  host_id    = centreon_host.db.id
+  service_id = 345
+  start_time = "2024-06-01T00:00:00Z"
+  end_time   = "2024-06-01T06:00:00Z"
+  fixed      = false
+  duration   = 3600
+  comment    = "Nightly backup"
+}
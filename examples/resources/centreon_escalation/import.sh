@@ -0,0 +1,2 @@
+# Escalations are imported by ID
+terraform import centreon_escalation.second_line 6
@@ -0,0 +1,18 @@
+# Page the second-line team from the third notification onward
+resource "centreon_escalation" "second_line" {
+  name                  = "second-line"
+  alias                 = "Escalate to second line"
+  first_notification    = 3
+  last_notification     = 0
+  notification_interval = 30
+  escalation_period_id  = 1
+
+  host_escalation_options    = ["down", "unreachable", "recovery"]
+  service_escalation_options = ["critical", "recovery"]
+
+  contact_group_ids = [4]
+  host_group_ids    = [2]
+  services = [
+    { host_id = 12, service_id = 345 },
+  ]
+}
@@ -13,8 +13,8 @@ resource "centreon_host" "web_server" {
   retry_check_interval  = 1 # Check every minute when not OK
 
   # Notification configuration
-  notification_enabled       = 1  # Enable notifications
-  notification_options       = 5  # DOWN (1) + RECOVERY (4)
+  notification_enabled       = true
+  notification_types         = ["down", "recovery"]
   notification_interval      = 30 # Notify every 30 minutes
   notification_timeperiod_id = 1  # Assuming 1 is 24x7 timeperiod
 
@@ -23,27 +23,28 @@ resource "centreon_host" "web_server" {
   groups     = [1] # Web servers group
   categories = [1] # Production category
 
-  # Custom macros
-  macros = [
-    {
-      name        = "HTTP_PORT"
+  # Custom macros, keyed by name
+  macros = {
+    HTTP_PORT = {
       value       = "80"
-      is_password = false
       description = "Web server port"
-    },
-    {
-      name        = "ENVIRONMENT"
+    }
+    ENVIRONMENT = {
       value       = "production"
-      is_password = false
       description = "Environment type"
     }
-  ]
+  }
 
   # Additional metadata
   comment      = "Main production web server"
   is_activated = true
 }
 
+variable "mysql_password" {
+  type      = string
+  sensitive = true
+}
+
 # Example of creating a database server with SNMP monitoring
 resource "centreon_host" "db_server" {
   monitoring_server_id = 1
@@ -66,27 +67,66 @@ resource "centreon_host" "db_server" {
   groups     = [2] # Database servers group
   categories = [1] # Production category
 
-  # Custom macros for database monitoring
-  macros = [
-    {
-      name        = "MYSQL_PORT"
+  # Custom macros for database monitoring. The password is write-only and
+  # only sent again when its version changes.
+  macros = {
+    MYSQL_PORT = {
       value       = "3306"
-      is_password = false
       description = "MySQL server port"
-    },
-    {
-      name        = "MYSQL_USER"
+    }
+    MYSQL_USER = {
       value       = "monitoring"
-      is_password = false
       description = "MySQL monitoring user"
-    },
-    {
-      name        = "MYSQL_PASSWORD"
-      value       = "secret123"
-      is_password = true
-      description = "MySQL monitoring user password"
     }
-  ]
+    MYSQL_PASSWORD = {
+      value_wo         = var.mysql_password
+      value_wo_version = 1
+      is_password      = true
+      description      = "MySQL monitoring user password"
+    }
+  }
 
   is_activated = true
 }
+
+# Example of referencing the monitoring server, templates, groups and
+# categories by name, so the same configuration works across environments
+resource "centreon_host" "app_server" {
+  monitoring_server_name = "Central"
+  name                   = "app-server-01"
+  address                = "192.168.1.102"
+
+  template_names = ["generic-active-host"]
+  group_names    = ["Linux-Servers"]
+  category_names = ["Production"]
+}
+
+# Example of a network topology: hosts behind the switch become UNREACHABLE
+# rather than DOWN when the switch fails
+resource "centreon_host" "switch" {
+  monitoring_server_id = 1
+  name                 = "core-switch-01"
+  address              = "192.168.1.1"
+}
+
+resource "centreon_host" "behind_switch" {
+  monitoring_server_id = 1
+  name                 = "app-server-02"
+  address              = "192.168.1.103"
+  parent_host_ids      = [centreon_host.switch.id]
+}
+
+# Example of waiting for the first check of the host, so that the following
+# steps of a pipeline can assume it is monitored
+resource "centreon_host" "smoke_tested" {
+  monitoring_server_id = 1
+  name                 = "app-server-03"
+  address              = "192.168.1.104"
+  templates            = [2]
+
+  wait_for_status = {
+    states        = ["up"]
+    timeout       = 600
+    poll_interval = 15
+  }
+}
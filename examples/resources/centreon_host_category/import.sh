@@ -0,0 +1,2 @@
+# Host categories are imported by ID
+terraform import centreon_host_category.production 12
@@ -0,0 +1,13 @@
+resource "centreon_host_category" "production" {
+  name    = "production"
+  alias   = "Production hosts"
+  comment = "Managed by Terraform"
+}
+
+# Link a host to the category
+resource "centreon_host" "web_server" {
+  monitoring_server_id = 1
+  name                 = "web-server-01"
+  address              = "192.168.1.100"
+  categories           = [centreon_host_category.production.id]
+}
@@ -0,0 +1,2 @@
+# Host dependencies are imported by ID
+terraform import centreon_host_dependency.core_switch 5
@@ -0,0 +1,11 @@
+# Silence the access switches' hosts while the core switch is down
+resource "centreon_host_dependency" "core_switch" {
+  name            = "core-switch"
+  description     = "Hosts behind the core switch"
+  parent_host_ids = [10]
+  child_host_ids  = [21, 22, 23]
+  inherits_parent = true
+
+  execution_failure_criteria    = ["down", "unreachable"]
+  notification_failure_criteria = ["down", "unreachable", "pending"]
+}
@@ -0,0 +1,2 @@
+# Host severities are imported by ID
+terraform import centreon_host_severity.critical 3
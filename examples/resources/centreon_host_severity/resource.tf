@@ -0,0 +1,14 @@
+resource "centreon_host_severity" "critical" {
+  name    = "critical"
+  alias   = "Business critical"
+  level   = 1
+  icon_id = 5
+}
+
+# Assign the severity to a host
+resource "centreon_host" "database" {
+  monitoring_server_id = 1
+  name                 = "db-server-01"
+  address              = "192.168.1.200"
+  severity_id          = centreon_host_severity.critical.id
+}
@@ -0,0 +1,2 @@
+# Meta services are imported by ID
+terraform import centreon_meta_service.web_cpu 3
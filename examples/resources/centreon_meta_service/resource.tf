@@ -0,0 +1,31 @@
+# Average CPU usage of the services matching a pattern
+resource "centreon_meta_service" "web_cpu" {
+  name             = "web-cluster-cpu"
+  output           = "Average CPU usage: %d%%"
+  calculation_type = "average"
+  regexp           = "%web%CPU%"
+  metric           = "cpu"
+  warning          = "80"
+  critical         = "90"
+
+  check_timeperiod_id   = 1
+  max_check_attempts    = 3
+  normal_check_interval = 5
+  retry_check_interval  = 1
+
+  notification_enabled = true
+  notification_types   = ["warning", "critical", "recovery"]
+  contact_group_ids    = [4]
+}
+
+# Total traffic of a list of service metrics
+resource "centreon_meta_service" "uplink_traffic" {
+  name             = "uplink-traffic"
+  calculation_type = "sum"
+  data_source_type = "counter"
+
+  metrics = [
+    { host_id = 12, service_id = 345, metric = "traffic_in" },
+    { host_id = 13, service_id = 346, metric = "traffic_in" },
+  ]
+}
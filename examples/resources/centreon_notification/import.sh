@@ -0,0 +1,2 @@
+# Notification rules are imported by ID
+terraform import centreon_notification.network_outages 3
@@ -0,0 +1,20 @@
+resource "centreon_notification" "network_outages" {
+  name              = "Network outages"
+  timeperiod_id     = 1
+  contact_group_ids = [4]
+
+  host_groups = {
+    ids            = [12, 13]
+    events         = ["down", "unreachable"]
+    service_events = ["critical"]
+  }
+
+  service_groups = {
+    ids    = [7]
+    events = ["warning", "critical"]
+  }
+
+  channels = ["email", "slack"]
+  subject  = "Network outage"
+  message  = "A network resource is in a problem state, check the Centreon resource status page."
+}
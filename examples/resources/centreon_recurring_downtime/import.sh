@@ -0,0 +1,2 @@
+# Recurring downtimes are imported by ID
+terraform import centreon_recurring_downtime.patching 9
@@ -0,0 +1,30 @@
+# Monthly patch window on the second tuesday, plus a weekly backup window
+resource "centreon_recurring_downtime" "patching" {
+  name        = "linux-patching"
+  description = "Monthly OS patching"
+
+  periods = [
+    {
+      nth_weekday = "second tuesday"
+      start_time  = "22:00"
+      end_time    = "24:00"
+    },
+    {
+      weekdays   = ["saturday", "sunday"]
+      start_time = "01:00"
+      end_time   = "05:00"
+      fixed      = false
+      duration   = 3600
+    },
+    {
+      month_days = [1, 15]
+      start_time = "03:00"
+      end_time   = "04:00"
+    },
+  ]
+
+  host_group_ids = [3]
+  services = [
+    { host_id = 12, service_id = 345 },
+  ]
+}
@@ -0,0 +1,2 @@
+# Resource access rules are imported by ID
+terraform import centreon_resource_access_rule.network_team 3
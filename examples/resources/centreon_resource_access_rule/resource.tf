@@ -0,0 +1,27 @@
+resource "centreon_resource_access_rule" "network_team" {
+  name              = "Network team"
+  description       = "Network devices, and the Linux routers of the Paris site"
+  contact_group_ids = [4]
+
+  datasets = [
+    {
+      host_group_ids = [12, 13]
+    },
+    {
+      monitoring_server_ids = [2]
+      host_category_ids     = [5]
+    },
+  ]
+}
+
+# Read-only access to every resource for the auditors
+resource "centreon_resource_access_rule" "auditors" {
+  name              = "Auditors"
+  contact_group_ids = [9]
+
+  datasets = [
+    {
+      all_monitoring_servers = true
+    },
+  ]
+}
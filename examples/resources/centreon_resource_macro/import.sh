@@ -0,0 +1,3 @@
+# Resource macros are imported by ID. Password values can't be read back and
+# are set from the configuration on the next apply
+terraform import centreon_resource_macro.plugins 2
@@ -0,0 +1,14 @@
+# Plugin path of the pollers running the new plugin packages
+resource "centreon_resource_macro" "plugins" {
+  name                  = "$CENTREONPLUGINS$"
+  value                 = "/usr/lib64/centreon-plugins"
+  comment               = "Centreon plugins path"
+  monitoring_server_ids = [1, 2]
+}
+
+resource "centreon_resource_macro" "snmp_community" {
+  name                  = "$USER10$"
+  value                 = var.snmp_community
+  is_password           = true
+  monitoring_server_ids = [1]
+}
@@ -0,0 +1,2 @@
+# Service categories are imported by ID
+terraform import centreon_service_category.payments 4
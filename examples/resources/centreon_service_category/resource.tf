@@ -0,0 +1,10 @@
+# Categories used by alert routing and dashboards
+resource "centreon_service_category" "payments" {
+  name  = "payments"
+  alias = "Payment platform"
+}
+
+resource "centreon_service_category" "legacy" {
+  name         = "legacy"
+  is_activated = false
+}
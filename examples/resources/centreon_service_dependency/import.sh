@@ -0,0 +1,2 @@
+# Service dependencies are imported by ID
+terraform import centreon_service_dependency.app_database 8
@@ -0,0 +1,15 @@
+# Don't alert on the application while its database is critical
+resource "centreon_service_dependency" "app_database" {
+  name = "app-database"
+
+  parent_services = [
+    { host_id = 30, service_id = 120 },
+  ]
+  child_services = [
+    { host_id = 31, service_id = 140 },
+    { host_id = 32, service_id = 141 },
+  ]
+
+  execution_failure_criteria    = ["none"]
+  notification_failure_criteria = ["critical", "unknown"]
+}
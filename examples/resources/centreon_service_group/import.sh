@@ -0,0 +1,2 @@
+# Service groups are imported by ID
+terraform import centreon_service_group.databases 7
@@ -0,0 +1,6 @@
+resource "centreon_service_group" "databases" {
+  name       = "databases"
+  alias      = "Database services"
+  geo_coords = "48.8566,2.3522"
+  comment    = "Managed by Terraform"
+}
@@ -0,0 +1,2 @@
+# Service severities are imported by ID
+terraform import centreon_service_severity.high 2
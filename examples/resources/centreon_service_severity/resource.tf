@@ -0,0 +1,6 @@
+resource "centreon_service_severity" "high" {
+  name    = "high"
+  alias   = "High impact"
+  level   = 2
+  icon_id = 5
+}
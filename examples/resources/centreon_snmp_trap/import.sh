@@ -0,0 +1,2 @@
+# SNMP traps are imported by ID
+terraform import centreon_snmp_trap.link_down 12
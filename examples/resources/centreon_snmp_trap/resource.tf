@@ -0,0 +1,18 @@
+resource "centreon_snmp_trap" "link_down" {
+  name      = "linkDown"
+  oid       = ".1.3.6.1.6.3.1.1.5.3"
+  vendor_id = centreon_vendor.cisco.id
+  output    = "Link down on interface $2. State: $4"
+  status    = "critical"
+
+  # Administratively down interfaces are expected
+  matching_rules = [
+    {
+      string = "$4"
+      regexp = "/^2$/"
+      status = "ok"
+    },
+  ]
+
+  service_template_ids = [42]
+}
@@ -0,0 +1,2 @@
+# Users are imported by ID
+terraform import centreon_user.network_team 21
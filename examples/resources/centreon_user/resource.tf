@@ -0,0 +1,13 @@
+variable "network_team_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "centreon_user" "network_team" {
+  name                        = "Network team automation"
+  alias                       = "svc-network"
+  email                       = "network-team@acme.lan"
+  password                    = var.network_team_password
+  can_reach_frontend          = false
+  can_reach_configuration_api = true
+}
@@ -0,0 +1,2 @@
+# Vendors are imported by ID
+terraform import centreon_vendor.cisco 2
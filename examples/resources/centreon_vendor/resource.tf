@@ -0,0 +1,5 @@
+resource "centreon_vendor" "cisco" {
+  name        = "Cisco"
+  alias       = "Cisco Networks"
+  description = "Cisco Systems, Inc."
+}
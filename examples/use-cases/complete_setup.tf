@@ -114,34 +114,25 @@ resource "centreon_host" "elastic_cluster" {
     [for g in data.centreon_host_groups.elastic.groups : g.id]
   )
 
-  # Common monitoring settings
-  active_check_enabled   = 2 # Use template default
-  passive_check_enabled  = 2 # Use template default
-  notification_enabled   = 1 # Enable notifications
-  notification_options   = 5 # DOWN (1) + RECOVERY (4)
-  notification_interval  = 30
-  max_check_attempts     = 3
-  normal_check_interval  = 5
-  retry_check_interval   = 1
-  freshness_checked      = 2 # Use template default
-  flap_detection_enabled = 2 # Use template default
-  event_handler_enabled  = 2 # Use template default
+  # Common monitoring settings, unset flags are inherited from the templates
+  notification_enabled  = true
+  notification_options  = 5 # DOWN (1) + RECOVERY (4)
+  notification_interval = 30
+  max_check_attempts    = 3
+  normal_check_interval = 5
+  retry_check_interval  = 1
 
   # Custom macros for Elastic Stack monitoring
-  macros = [
-    {
-      name        = "_ES_PORT"
+  macros = {
+    _ES_PORT = {
       value       = "9200"
-      is_password = false
       description = "Elasticsearch HTTP port"
-    },
-    {
-      name        = "_CLUSTER_NAME"
+    }
+    _CLUSTER_NAME = {
       value       = "production"
-      is_password = false
       description = "Elasticsearch cluster name"
     }
-  ]
+  }
 
   is_activated = true
 }
@@ -0,0 +1,171 @@
+package client
+
+import "fmt"
+
+// ACLGroup grants its contacts and contact groups the resources, menus and
+// actions of its access lists.
+type ACLGroup struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Alias            string `json:"alias"`
+	IsActivated      bool   `json:"is_activated"`
+	Contacts         []int  `json:"contacts"`
+	ContactGroups    []int  `json:"contact_groups"`
+	ResourceAccesses []int  `json:"resource_accesses"`
+	MenuAccesses     []int  `json:"menu_accesses"`
+	ActionAccesses   []int  `json:"action_accesses"`
+}
+
+// ACLGroupRequest is the payload used to create or update an ACL group.
+type ACLGroupRequest struct {
+	Name             string `json:"name"`
+	Alias            string `json:"alias"`
+	IsActivated      bool   `json:"is_activated"`
+	Contacts         []int  `json:"contacts"`
+	ContactGroups    []int  `json:"contact_groups"`
+	ResourceAccesses []int  `json:"resource_accesses"`
+	MenuAccesses     []int  `json:"menu_accesses"`
+	ActionAccesses   []int  `json:"action_accesses"`
+}
+
+// ACLResourceAccess lists the resources visible to the ACL groups using it.
+// The "all" flags include the resources created later.
+type ACLResourceAccess struct {
+	ID                int     `json:"id"`
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsActivated       bool    `json:"is_activated"`
+	AllHosts          bool    `json:"all_hosts"`
+	AllHostGroups     bool    `json:"all_host_groups"`
+	AllServiceGroups  bool    `json:"all_service_groups"`
+	Hosts             []int   `json:"hosts"`
+	ExcludedHosts     []int   `json:"excluded_hosts"`
+	HostGroups        []int   `json:"host_groups"`
+	ServiceGroups     []int   `json:"service_groups"`
+	HostCategories    []int   `json:"host_categories"`
+	ServiceCategories []int   `json:"service_categories"`
+	MonitoringServers []int   `json:"monitoring_servers"`
+	MetaServices      []int   `json:"meta_services"`
+}
+
+// ACLResourceAccessRequest is the payload used to create or update an ACL
+// resource access.
+type ACLResourceAccessRequest struct {
+	Name              string  `json:"name"`
+	Description       *string `json:"description"`
+	IsActivated       bool    `json:"is_activated"`
+	AllHosts          bool    `json:"all_hosts"`
+	AllHostGroups     bool    `json:"all_host_groups"`
+	AllServiceGroups  bool    `json:"all_service_groups"`
+	Hosts             []int   `json:"hosts"`
+	ExcludedHosts     []int   `json:"excluded_hosts"`
+	HostGroups        []int   `json:"host_groups"`
+	ServiceGroups     []int   `json:"service_groups"`
+	HostCategories    []int   `json:"host_categories"`
+	ServiceCategories []int   `json:"service_categories"`
+	MonitoringServers []int   `json:"monitoring_servers"`
+	MetaServices      []int   `json:"meta_services"`
+}
+
+// ACLActionAccess lists the monitoring actions allowed to the ACL groups
+// using it.
+type ACLActionAccess struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	IsActivated bool     `json:"is_activated"`
+	Actions     []string `json:"actions"`
+}
+
+// ACLActionAccessRequest is the payload used to create or update an ACL
+// action access.
+type ACLActionAccessRequest struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	IsActivated bool     `json:"is_activated"`
+	Actions     []string `json:"actions"`
+}
+
+// GetACLGroup retrieves the ACL group with the given ID.
+func (c *Client) GetACLGroup(id int) (*ACLGroup, error) {
+	var group ACLGroup
+	if err := c.getJSON(fmt.Sprintf("%s/administration/acl/groups/%d", c.BaseURL, id), &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CreateACLGroup creates an ACL group and returns it.
+func (c *Client) CreateACLGroup(group *ACLGroupRequest) (*ACLGroup, error) {
+	var created ACLGroup
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/administration/acl/groups", c.BaseURL), group, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateACLGroup replaces the ACL group with the given ID.
+func (c *Client) UpdateACLGroup(id int, group *ACLGroupRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/administration/acl/groups/%d", c.BaseURL, id), group, nil)
+}
+
+// DeleteACLGroup deletes the ACL group with the given ID.
+func (c *Client) DeleteACLGroup(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/administration/acl/groups/%d", c.BaseURL, id))
+}
+
+// GetACLResourceAccess retrieves the ACL resource access with the given ID.
+func (c *Client) GetACLResourceAccess(id int) (*ACLResourceAccess, error) {
+	var access ACLResourceAccess
+	if err := c.getJSON(fmt.Sprintf("%s/administration/acl/resource-accesses/%d", c.BaseURL, id), &access); err != nil {
+		return nil, err
+	}
+	return &access, nil
+}
+
+// CreateACLResourceAccess creates an ACL resource access and returns it.
+func (c *Client) CreateACLResourceAccess(access *ACLResourceAccessRequest) (*ACLResourceAccess, error) {
+	var created ACLResourceAccess
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/administration/acl/resource-accesses", c.BaseURL), access, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateACLResourceAccess replaces the ACL resource access with the given ID.
+func (c *Client) UpdateACLResourceAccess(id int, access *ACLResourceAccessRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/administration/acl/resource-accesses/%d", c.BaseURL, id), access, nil)
+}
+
+// DeleteACLResourceAccess deletes the ACL resource access with the given ID.
+func (c *Client) DeleteACLResourceAccess(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/administration/acl/resource-accesses/%d", c.BaseURL, id))
+}
+
+// GetACLActionAccess retrieves the ACL action access with the given ID.
+func (c *Client) GetACLActionAccess(id int) (*ACLActionAccess, error) {
+	var access ACLActionAccess
+	if err := c.getJSON(fmt.Sprintf("%s/administration/acl/action-accesses/%d", c.BaseURL, id), &access); err != nil {
+		return nil, err
+	}
+	return &access, nil
+}
+
+// CreateACLActionAccess creates an ACL action access and returns it.
+func (c *Client) CreateACLActionAccess(access *ACLActionAccessRequest) (*ACLActionAccess, error) {
+	var created ACLActionAccess
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/administration/acl/action-accesses", c.BaseURL), access, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateACLActionAccess replaces the ACL action access with the given ID.
+func (c *Client) UpdateACLActionAccess(id int, access *ACLActionAccessRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/administration/acl/action-accesses/%d", c.BaseURL, id), access, nil)
+}
+
+// DeleteACLActionAccess deletes the ACL action access with the given ID.
+func (c *Client) DeleteACLActionAccess(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/administration/acl/action-accesses/%d", c.BaseURL, id))
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// APITokenUser identifies the owner or the creator of an API token.
+type APITokenUser struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// APIToken is an API token of a user. Tokens are identified by their name and
+// their user, and the token value is only returned on creation.
+type APIToken struct {
+	Name           string       `json:"name"`
+	User           APITokenUser `json:"user"`
+	Creator        APITokenUser `json:"creator"`
+	CreationDate   time.Time    `json:"creation_date"`
+	ExpirationDate time.Time    `json:"expiration_date"`
+	IsRevoked      bool         `json:"is_revoked"`
+	Token          *string      `json:"token"`
+}
+
+// APITokenRequest is the payload used to create an API token.
+type APITokenRequest struct {
+	Name           string    `json:"name"`
+	UserID         int       `json:"user_id"`
+	ExpirationDate time.Time `json:"expiration_date"`
+}
+
+// apiTokenURL returns the URL of the API token with the given name of the
+// given user.
+func (c *Client) apiTokenURL(name string, userID int) string {
+	return fmt.Sprintf("%s/administration/tokens/%s/users/%d", c.BaseURL, url.PathEscape(name), userID)
+}
+
+// GetAPIToken retrieves the API token with the given name of the given user.
+func (c *Client) GetAPIToken(name string, userID int) (*APIToken, error) {
+	var token APIToken
+	if err := c.getJSON(c.apiTokenURL(name, userID), &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CreateAPIToken creates an API token and returns it, along with its value.
+func (c *Client) CreateAPIToken(token *APITokenRequest) (*APIToken, error) {
+	var created APIToken
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/administration/tokens", c.BaseURL), token, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// RevokeAPIToken revokes the API token with the given name of the given user.
+func (c *Client) RevokeAPIToken(name string, userID int) error {
+	body := struct {
+		IsRevoked bool `json:"is_revoked"`
+	}{true}
+	return c.sendJSON("PATCH", c.apiTokenURL(name, userID), body, nil)
+}
+
+// DeleteAPIToken deletes the API token with the given name of the given user.
+// Revoked tokens are kept until they are deleted, and their name can't be
+// reused until then.
+func (c *Client) DeleteAPIToken(name string, userID int) error {
+	return c.deleteObject(c.apiTokenURL(name, userID))
+}
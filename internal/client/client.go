@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"sync"
 	"terraform-provider-centreon/internal/logging"
 )
 
@@ -19,6 +22,10 @@ type Client struct {
 	APIVersion                     string
 	HTTPClient                     *http.Client
 	GenerateAndReloadConfiguration bool
+	ValidateReferences             bool
+
+	referencesMu sync.Mutex
+	references   map[string]bool
 }
 
 type PlatformInfo struct {
@@ -204,6 +211,21 @@ type CreateHostRequest struct {
 	GeoCoords                 *string     `json:"geo_coords,omitempty"`
 }
 
+// HostPatch holds the fields of a partial host update, keyed by their JSON
+// name. Fields missing from the patch are left untouched while fields set to
+// nil are sent as null, which clears them.
+type HostPatch map[string]interface{}
+
+// Fields returns the sorted names of the fields in the patch.
+func (p HostPatch) Fields() []string {
+	fields := make([]string, 0, len(p))
+	for field := range p {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
 type HostMacro struct {
 	Name        string  `json:"name"`
 	Value       *string `json:"value"`
@@ -346,22 +368,24 @@ func (c *Client) CreateHost(host *CreateHostRequest) error {
 	return nil
 }
 
-func (c *Client) UpdateHost(host *CreateHostRequest) error {
-	hosts, err := c.GetHosts(1, 1, fmt.Sprintf("{\"name\":\"%s\"}", host.Name))
+// UpdateHost partially updates the host with the given name. Only the fields
+// present in the patch are modified.
+func (c *Client) UpdateHost(name string, patch HostPatch) error {
+	hosts, err := c.GetHosts(1, 1, fmt.Sprintf("{\"name\":\"%s\"}", name))
 	if err != nil {
 		return fmt.Errorf("error getting host ID: %v", err)
 	}
 	if len(hosts.Result) == 0 {
 		return &APIError{
 			StatusCode: http.StatusNotFound,
-			Message:    fmt.Sprintf("Host not found: %s", host.Name),
+			Message:    fmt.Sprintf("Host not found: %s", name),
 			Code:       "NOT_FOUND",
 		}
 	}
 
 	hostID := hosts.Result[0].ID
 	url := fmt.Sprintf("%s/configuration/hosts/%d", c.BaseURL, hostID)
-	jsonData, err := json.Marshal(host)
+	jsonData, err := json.Marshal(patch)
 	if err != nil {
 		return fmt.Errorf("error marshaling host data: %v", err)
 	}
@@ -523,3 +547,181 @@ func (c *Client) GetHostMacros(hostID int) ([]HostMacro, error) {
 
 	return macroResponse.Result, nil
 }
+
+// nameSearch builds an escaped search parameter matching the given name exactly.
+func nameSearch(name string) string {
+	search, _ := json.Marshal(map[string]string{"name": name})
+	return url.QueryEscape(string(search))
+}
+
+// idSearch builds an escaped search parameter matching the given ID.
+func idSearch(id int) string {
+	search, _ := json.Marshal(map[string]int{"id": id})
+	return url.QueryEscape(string(search))
+}
+
+// notFound returns the error reported when a lookup by name has no match.
+func notFound(kind, name string) error {
+	return &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("%s not found: %s", kind, name),
+		Code:       "NOT_FOUND",
+	}
+}
+
+// getJSON performs a GET request on the given URL and decodes the JSON response into out.
+func (c *Client) getJSON(url string, out interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %v", err)
+	}
+	return nil
+}
+
+// sendJSON sends body encoded as JSON and decodes the response into out, when
+// out is not nil.
+func (c *Client) sendJSON(method, url string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request data: %v", err)
+		}
+		reader = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("error decoding response: %v", err)
+		}
+	}
+	return nil
+}
+
+// deleteObject deletes the configuration object at the given URL.
+func (c *Client) deleteObject(url string) error {
+	return c.sendJSON("DELETE", url, nil, nil)
+}
+
+// FindMonitoringServerByName returns the monitoring server with the given name.
+func (c *Client) FindMonitoringServerByName(name string) (*MonitoringServerDetail, error) {
+	servers, err := c.GetMonitoringServers(10, 1, nameSearch(name))
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range servers.Result {
+		if server.Name == name {
+			return &server, nil
+		}
+	}
+	return nil, notFound("Monitoring server", name)
+}
+
+// FindHostTemplateByName returns the host template with the given name.
+func (c *Client) FindHostTemplateByName(name string) (*HostTemplate, error) {
+	templates, err := c.GetHostTemplates(10, 1, nameSearch(name))
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range templates.Result {
+		if template.Name == name {
+			return &template, nil
+		}
+	}
+	return nil, notFound("Host template", name)
+}
+
+// FindHostGroupByName returns the host group with the given name. Unlike
+// GetHostGroups, which lists groups known to the real-time monitoring, the
+// lookup is done on the configuration so that empty groups are found too.
+func (c *Client) FindHostGroupByName(name string) (*HostGroup, error) {
+	url := fmt.Sprintf("%s/configuration/hosts/groups?limit=%d&page=%d&search=%s",
+		c.BaseURL, 10, 1, nameSearch(name))
+
+	var groups HostGroupsResponse
+	if err := c.getJSON(url, &groups); err != nil {
+		return nil, err
+	}
+	for _, group := range groups.Result {
+		if group.Name == name {
+			return &group, nil
+		}
+	}
+	return nil, notFound("Host group", name)
+}
+
+// ReferenceKind identifies a configuration object type that can be referenced
+// by ID. Its value is the object's endpoint below /configuration.
+type ReferenceKind string
+
+const (
+	ReferenceMonitoringServer ReferenceKind = "monitoring-servers"
+	ReferenceHostTemplate     ReferenceKind = "hosts/templates"
+	ReferenceHostGroup        ReferenceKind = "hosts/groups"
+	ReferenceCommand          ReferenceKind = "commands"
+)
+
+// ReferenceExists reports whether the object of the given kind and ID exists.
+// Results are cached on the client, so each object is only looked up once
+// per run.
+func (c *Client) ReferenceExists(kind ReferenceKind, id int) (bool, error) {
+	key := fmt.Sprintf("%s/%d", kind, id)
+
+	c.referencesMu.Lock()
+	exists, cached := c.references[key]
+	c.referencesMu.Unlock()
+	if cached {
+		return exists, nil
+	}
+
+	url := fmt.Sprintf("%s/configuration/%s?limit=%d&page=%d&search=%s",
+		c.BaseURL, kind, 10, 1, idSearch(id))
+
+	var response struct {
+		Result []struct {
+			ID int `json:"id"`
+		} `json:"result"`
+	}
+	if err := c.getJSON(url, &response); err != nil {
+		return false, err
+	}
+	for _, object := range response.Result {
+		if object.ID == id {
+			exists = true
+			break
+		}
+	}
+
+	c.referencesMu.Lock()
+	if c.references == nil {
+		c.references = make(map[string]bool)
+	}
+	c.references[key] = exists
+	c.referencesMu.Unlock()
+
+	return exists, nil
+}
@@ -0,0 +1,68 @@
+package client
+
+import "fmt"
+
+// Connector is a persistent process, such as the Perl or SSH connector,
+// running the checks of the linked commands.
+type Connector struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	CommandLine string  `json:"command_line"`
+	IsActivated bool    `json:"is_activated"`
+	Commands    []int   `json:"commands"`
+}
+
+type ConnectorsResponse struct {
+	Result []Connector `json:"result"`
+	Meta   Meta        `json:"meta"`
+}
+
+// ConnectorRequest is the payload used to create or update a connector.
+type ConnectorRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	CommandLine string  `json:"command_line"`
+	IsActivated bool    `json:"is_activated"`
+	Commands    []int   `json:"commands"`
+}
+
+// GetConnectors retrieves the connectors defined in the configuration.
+func (c *Client) GetConnectors(limit int, page int, search string) (*ConnectorsResponse, error) {
+	url := fmt.Sprintf("%s/configuration/connectors?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response ConnectorsResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetConnector retrieves the connector with the given ID.
+func (c *Client) GetConnector(id int) (*Connector, error) {
+	var connector Connector
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/connectors/%d", c.BaseURL, id), &connector); err != nil {
+		return nil, err
+	}
+	return &connector, nil
+}
+
+// CreateConnector creates a connector and returns it.
+func (c *Client) CreateConnector(connector *ConnectorRequest) (*Connector, error) {
+	var created Connector
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/connectors", c.BaseURL), connector, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateConnector replaces the connector with the given ID.
+func (c *Client) UpdateConnector(id int, connector *ConnectorRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/connectors/%d", c.BaseURL, id), connector, nil)
+}
+
+// DeleteConnector deletes the connector with the given ID.
+func (c *Client) DeleteConnector(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/connectors/%d", c.BaseURL, id))
+}
@@ -0,0 +1,116 @@
+package client
+
+import "fmt"
+
+// HostDependency is a dependency between parent and child hosts. The failure
+// criteria are comma-separated state codes.
+type HostDependency struct {
+	ID                          int     `json:"id"`
+	Name                        string  `json:"name"`
+	Description                 *string `json:"description"`
+	Parents                     []int   `json:"parents"`
+	Children                    []int   `json:"children"`
+	InheritsParent              bool    `json:"inherits_parent"`
+	ExecutionFailureCriteria    string  `json:"execution_failure_criteria"`
+	NotificationFailureCriteria string  `json:"notification_failure_criteria"`
+	Comment                     *string `json:"comment"`
+}
+
+// HostDependencyRequest is the payload used to create or update a host dependency.
+type HostDependencyRequest struct {
+	Name                        string  `json:"name"`
+	Description                 *string `json:"description"`
+	Parents                     []int   `json:"parents"`
+	Children                    []int   `json:"children"`
+	InheritsParent              bool    `json:"inherits_parent"`
+	ExecutionFailureCriteria    string  `json:"execution_failure_criteria"`
+	NotificationFailureCriteria string  `json:"notification_failure_criteria"`
+	Comment                     *string `json:"comment"`
+}
+
+// ServiceReference identifies a service by its host.
+type ServiceReference struct {
+	HostID    int `json:"host_id"`
+	ServiceID int `json:"service_id"`
+}
+
+// ServiceDependency is a dependency between parent and child services.
+type ServiceDependency struct {
+	ID                          int                `json:"id"`
+	Name                        string             `json:"name"`
+	Description                 *string            `json:"description"`
+	Parents                     []ServiceReference `json:"parents"`
+	Children                    []ServiceReference `json:"children"`
+	InheritsParent              bool               `json:"inherits_parent"`
+	ExecutionFailureCriteria    string             `json:"execution_failure_criteria"`
+	NotificationFailureCriteria string             `json:"notification_failure_criteria"`
+	Comment                     *string            `json:"comment"`
+}
+
+// ServiceDependencyRequest is the payload used to create or update a service dependency.
+type ServiceDependencyRequest struct {
+	Name                        string             `json:"name"`
+	Description                 *string            `json:"description"`
+	Parents                     []ServiceReference `json:"parents"`
+	Children                    []ServiceReference `json:"children"`
+	InheritsParent              bool               `json:"inherits_parent"`
+	ExecutionFailureCriteria    string             `json:"execution_failure_criteria"`
+	NotificationFailureCriteria string             `json:"notification_failure_criteria"`
+	Comment                     *string            `json:"comment"`
+}
+
+// GetHostDependency retrieves the host dependency with the given ID.
+func (c *Client) GetHostDependency(id int) (*HostDependency, error) {
+	var dependency HostDependency
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/hosts/dependencies/%d", c.BaseURL, id), &dependency); err != nil {
+		return nil, err
+	}
+	return &dependency, nil
+}
+
+// CreateHostDependency creates a host dependency and returns it.
+func (c *Client) CreateHostDependency(dependency *HostDependencyRequest) (*HostDependency, error) {
+	var created HostDependency
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/hosts/dependencies", c.BaseURL), dependency, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateHostDependency replaces the host dependency with the given ID.
+func (c *Client) UpdateHostDependency(id int, dependency *HostDependencyRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/hosts/dependencies/%d", c.BaseURL, id), dependency, nil)
+}
+
+// DeleteHostDependency deletes the host dependency with the given ID.
+func (c *Client) DeleteHostDependency(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/hosts/dependencies/%d", c.BaseURL, id))
+}
+
+// GetServiceDependency retrieves the service dependency with the given ID.
+func (c *Client) GetServiceDependency(id int) (*ServiceDependency, error) {
+	var dependency ServiceDependency
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/services/dependencies/%d", c.BaseURL, id), &dependency); err != nil {
+		return nil, err
+	}
+	return &dependency, nil
+}
+
+// CreateServiceDependency creates a service dependency and returns it.
+func (c *Client) CreateServiceDependency(dependency *ServiceDependencyRequest) (*ServiceDependency, error) {
+	var created ServiceDependency
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/services/dependencies", c.BaseURL), dependency, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateServiceDependency replaces the service dependency with the given ID.
+func (c *Client) UpdateServiceDependency(id int, dependency *ServiceDependencyRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/services/dependencies/%d", c.BaseURL, id), dependency, nil)
+}
+
+// DeleteServiceDependency deletes the service dependency with the given ID.
+func (c *Client) DeleteServiceDependency(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/services/dependencies/%d", c.BaseURL, id))
+}
@@ -0,0 +1,87 @@
+package client
+
+import "fmt"
+
+// Escalation notifies additional contact groups once a host or service has
+// sent a given number of notifications. Escalation options are
+// comma-separated state codes.
+type Escalation struct {
+	ID                       int                `json:"id"`
+	Name                     string             `json:"name"`
+	Alias                    *string            `json:"alias"`
+	FirstNotification        int                `json:"first_notification"`
+	LastNotification         int                `json:"last_notification"`
+	NotificationInterval     *int               `json:"notification_interval"`
+	EscalationPeriodID       *int               `json:"escalation_period_id"`
+	HostEscalationOptions    string             `json:"host_escalation_options"`
+	ServiceEscalationOptions string             `json:"service_escalation_options"`
+	ContactGroups            []int              `json:"contact_groups"`
+	Hosts                    []int              `json:"hosts"`
+	HostGroups               []int              `json:"host_groups"`
+	Services                 []ServiceReference `json:"services"`
+	ServiceGroups            []int              `json:"service_groups"`
+	Comment                  *string            `json:"comment"`
+}
+
+type EscalationsResponse struct {
+	Result []Escalation `json:"result"`
+	Meta   Meta         `json:"meta"`
+}
+
+// EscalationRequest is the payload used to create or update an escalation.
+type EscalationRequest struct {
+	Name                     string             `json:"name"`
+	Alias                    *string            `json:"alias"`
+	FirstNotification        int                `json:"first_notification"`
+	LastNotification         int                `json:"last_notification"`
+	NotificationInterval     *int               `json:"notification_interval"`
+	EscalationPeriodID       *int               `json:"escalation_period_id"`
+	HostEscalationOptions    string             `json:"host_escalation_options"`
+	ServiceEscalationOptions string             `json:"service_escalation_options"`
+	ContactGroups            []int              `json:"contact_groups"`
+	Hosts                    []int              `json:"hosts"`
+	HostGroups               []int              `json:"host_groups"`
+	Services                 []ServiceReference `json:"services"`
+	ServiceGroups            []int              `json:"service_groups"`
+	Comment                  *string            `json:"comment"`
+}
+
+// GetEscalations retrieves the escalations defined in the configuration.
+func (c *Client) GetEscalations(limit int, page int, search string) (*EscalationsResponse, error) {
+	url := fmt.Sprintf("%s/configuration/escalations?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response EscalationsResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetEscalation retrieves the escalation with the given ID.
+func (c *Client) GetEscalation(id int) (*Escalation, error) {
+	var escalation Escalation
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/escalations/%d", c.BaseURL, id), &escalation); err != nil {
+		return nil, err
+	}
+	return &escalation, nil
+}
+
+// CreateEscalation creates an escalation and returns it.
+func (c *Client) CreateEscalation(escalation *EscalationRequest) (*Escalation, error) {
+	var created Escalation
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/escalations", c.BaseURL), escalation, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateEscalation replaces the escalation with the given ID.
+func (c *Client) UpdateEscalation(id int, escalation *EscalationRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/escalations/%d", c.BaseURL, id), escalation, nil)
+}
+
+// DeleteEscalation deletes the escalation with the given ID.
+func (c *Client) DeleteEscalation(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/escalations/%d", c.BaseURL, id))
+}
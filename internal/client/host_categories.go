@@ -0,0 +1,78 @@
+package client
+
+import "fmt"
+
+type HostCategory struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	IsActivated bool    `json:"is_activated"`
+	Comment     *string `json:"comment"`
+}
+
+type HostCategoriesResponse struct {
+	Result []HostCategory `json:"result"`
+	Meta   Meta           `json:"meta"`
+}
+
+// HostCategoryRequest is the payload used to create or update a host category.
+type HostCategoryRequest struct {
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	IsActivated bool    `json:"is_activated"`
+	Comment     *string `json:"comment"`
+}
+
+// GetHostCategories retrieves the host categories defined in the configuration.
+func (c *Client) GetHostCategories(limit int, page int, search string) (*HostCategoriesResponse, error) {
+	url := fmt.Sprintf("%s/configuration/hosts/categories?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response HostCategoriesResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetHostCategory retrieves the host category with the given ID.
+func (c *Client) GetHostCategory(id int) (*HostCategory, error) {
+	var category HostCategory
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/hosts/categories/%d", c.BaseURL, id), &category); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// CreateHostCategory creates a host category and returns it.
+func (c *Client) CreateHostCategory(category *HostCategoryRequest) (*HostCategory, error) {
+	var created HostCategory
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/hosts/categories", c.BaseURL), category, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateHostCategory replaces the host category with the given ID.
+func (c *Client) UpdateHostCategory(id int, category *HostCategoryRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/hosts/categories/%d", c.BaseURL, id), category, nil)
+}
+
+// DeleteHostCategory deletes the host category with the given ID.
+func (c *Client) DeleteHostCategory(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/hosts/categories/%d", c.BaseURL, id))
+}
+
+// FindHostCategoryByName returns the host category with the given name.
+func (c *Client) FindHostCategoryByName(name string) (*HostCategory, error) {
+	categories, err := c.GetHostCategories(10, 1, nameSearch(name))
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range categories.Result {
+		if category.Name == name {
+			return &category, nil
+		}
+	}
+	return nil, notFound("Host category", name)
+}
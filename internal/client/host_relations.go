@@ -0,0 +1,69 @@
+package client
+
+import "fmt"
+
+type hostRelationsResponse struct {
+	Result []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"result"`
+}
+
+// FindHostIDByName returns the ID of the host with the given name.
+func (c *Client) FindHostIDByName(name string) (int, error) {
+	hosts, err := c.GetHosts(10, 1, nameSearch(name))
+	if err != nil {
+		return 0, err
+	}
+	for _, host := range hosts.Result {
+		if host.Name == name {
+			return host.ID, nil
+		}
+	}
+	return 0, notFound("Host", name)
+}
+
+// getHostRelations returns the IDs of the hosts linked to the host through
+// the given relation, either "parents" or "children".
+func (c *Client) getHostRelations(hostID int, relation string) ([]int, error) {
+	var response hostRelationsResponse
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/hosts/%d/%s", c.BaseURL, hostID, relation), &response); err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(response.Result))
+	for i, host := range response.Result {
+		ids[i] = host.ID
+	}
+	return ids, nil
+}
+
+// setHostRelations replaces the hosts linked to the host through the given
+// relation, either "parents" or "children".
+func (c *Client) setHostRelations(hostID int, relation string, ids []int) error {
+	if ids == nil {
+		ids = []int{}
+	}
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/hosts/%d/%s", c.BaseURL, hostID, relation),
+		map[string][]int{relation: ids}, nil)
+}
+
+// GetHostParents returns the IDs of the parents of the host.
+func (c *Client) GetHostParents(hostID int) ([]int, error) {
+	return c.getHostRelations(hostID, "parents")
+}
+
+// SetHostParents replaces the parents of the host.
+func (c *Client) SetHostParents(hostID int, parents []int) error {
+	return c.setHostRelations(hostID, "parents", parents)
+}
+
+// GetHostChildren returns the IDs of the children of the host.
+func (c *Client) GetHostChildren(hostID int) ([]int, error) {
+	return c.getHostRelations(hostID, "children")
+}
+
+// SetHostChildren replaces the children of the host.
+func (c *Client) SetHostChildren(hostID int, children []int) error {
+	return c.setHostRelations(hostID, "children", children)
+}
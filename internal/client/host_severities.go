@@ -0,0 +1,68 @@
+package client
+
+import "fmt"
+
+type HostSeverity struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	Level       int     `json:"level"`
+	IconID      int     `json:"icon_id"`
+	IsActivated bool    `json:"is_activated"`
+	Comment     *string `json:"comment"`
+}
+
+type HostSeveritiesResponse struct {
+	Result []HostSeverity `json:"result"`
+	Meta   Meta           `json:"meta"`
+}
+
+// HostSeverityRequest is the payload used to create or update a host severity.
+type HostSeverityRequest struct {
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	Level       int     `json:"level"`
+	IconID      int     `json:"icon_id"`
+	IsActivated bool    `json:"is_activated"`
+	Comment     *string `json:"comment"`
+}
+
+// GetHostSeverities retrieves the host severities defined in the configuration.
+func (c *Client) GetHostSeverities(limit int, page int, search string) (*HostSeveritiesResponse, error) {
+	url := fmt.Sprintf("%s/configuration/hosts/severities?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response HostSeveritiesResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetHostSeverity retrieves the host severity with the given ID.
+func (c *Client) GetHostSeverity(id int) (*HostSeverity, error) {
+	var severity HostSeverity
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/hosts/severities/%d", c.BaseURL, id), &severity); err != nil {
+		return nil, err
+	}
+	return &severity, nil
+}
+
+// CreateHostSeverity creates a host severity and returns it.
+func (c *Client) CreateHostSeverity(severity *HostSeverityRequest) (*HostSeverity, error) {
+	var created HostSeverity
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/hosts/severities", c.BaseURL), severity, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateHostSeverity replaces the host severity with the given ID.
+func (c *Client) UpdateHostSeverity(id int, severity *HostSeverityRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/hosts/severities/%d", c.BaseURL, id), severity, nil)
+}
+
+// DeleteHostSeverity deletes the host severity with the given ID.
+func (c *Client) DeleteHostSeverity(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/hosts/severities/%d", c.BaseURL, id))
+}
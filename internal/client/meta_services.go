@@ -0,0 +1,99 @@
+package client
+
+import "fmt"
+
+// Meta service metric selection modes.
+const (
+	MetaServiceSelectionRegexp = 1
+	MetaServiceSelectionList   = 2
+)
+
+// MetaServiceMetric is a metric of a service aggregated by a meta service.
+type MetaServiceMetric struct {
+	HostID    int    `json:"host_id"`
+	ServiceID int    `json:"service_id"`
+	Metric    string `json:"metric_name"`
+}
+
+// MetaService aggregates a metric of several services into a virtual
+// service. Metrics are selected either with a SQL LIKE pattern on service
+// descriptions and a metric name, or with a list of service metrics.
+type MetaService struct {
+	ID                       int                 `json:"id"`
+	Name                     string              `json:"name"`
+	Output                   *string             `json:"output"`
+	CalculationType          string              `json:"calculation_type"`
+	DataSourceType           string              `json:"data_source_type"`
+	SelectionMode            int                 `json:"meta_selection_mode"`
+	RegexpString             *string             `json:"regexp_string"`
+	Metric                   *string             `json:"metric"`
+	Metrics                  []MetaServiceMetric `json:"metrics"`
+	Warning                  *string             `json:"warning"`
+	Critical                 *string             `json:"critical"`
+	CheckTimeperiodID        *int                `json:"check_timeperiod_id"`
+	MaxCheckAttempts         *int                `json:"max_check_attempts"`
+	NormalCheckInterval      *int                `json:"normal_check_interval"`
+	RetryCheckInterval       *int                `json:"retry_check_interval"`
+	IsNotificationEnabled    *bool               `json:"is_notification_enabled"`
+	NotificationOptions      *int                `json:"notification_options"`
+	NotificationInterval     *int                `json:"notification_interval"`
+	NotificationTimeperiodID *int                `json:"notification_timeperiod_id"`
+	Contacts                 []int               `json:"contacts"`
+	ContactGroups            []int               `json:"contact_groups"`
+	IsActivated              bool                `json:"is_activated"`
+	Comment                  *string             `json:"comment"`
+}
+
+// MetaServiceRequest is the payload used to create or update a meta service.
+type MetaServiceRequest struct {
+	Name                     string              `json:"name"`
+	Output                   *string             `json:"output"`
+	CalculationType          string              `json:"calculation_type"`
+	DataSourceType           string              `json:"data_source_type"`
+	SelectionMode            int                 `json:"meta_selection_mode"`
+	RegexpString             *string             `json:"regexp_string"`
+	Metric                   *string             `json:"metric"`
+	Metrics                  []MetaServiceMetric `json:"metrics"`
+	Warning                  *string             `json:"warning"`
+	Critical                 *string             `json:"critical"`
+	CheckTimeperiodID        *int                `json:"check_timeperiod_id"`
+	MaxCheckAttempts         *int                `json:"max_check_attempts"`
+	NormalCheckInterval      *int                `json:"normal_check_interval"`
+	RetryCheckInterval       *int                `json:"retry_check_interval"`
+	IsNotificationEnabled    *bool               `json:"is_notification_enabled"`
+	NotificationOptions      *int                `json:"notification_options"`
+	NotificationInterval     *int                `json:"notification_interval"`
+	NotificationTimeperiodID *int                `json:"notification_timeperiod_id"`
+	Contacts                 []int               `json:"contacts"`
+	ContactGroups            []int               `json:"contact_groups"`
+	IsActivated              bool                `json:"is_activated"`
+	Comment                  *string             `json:"comment"`
+}
+
+// GetMetaService retrieves the meta service with the given ID.
+func (c *Client) GetMetaService(id int) (*MetaService, error) {
+	var metaService MetaService
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/metaservices/%d", c.BaseURL, id), &metaService); err != nil {
+		return nil, err
+	}
+	return &metaService, nil
+}
+
+// CreateMetaService creates a meta service and returns it.
+func (c *Client) CreateMetaService(metaService *MetaServiceRequest) (*MetaService, error) {
+	var created MetaService
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/metaservices", c.BaseURL), metaService, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateMetaService replaces the meta service with the given ID.
+func (c *Client) UpdateMetaService(id int, metaService *MetaServiceRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/metaservices/%d", c.BaseURL, id), metaService, nil)
+}
+
+// DeleteMetaService deletes the meta service with the given ID.
+func (c *Client) DeleteMetaService(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/metaservices/%d", c.BaseURL, id))
+}
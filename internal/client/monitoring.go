@@ -0,0 +1,298 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Monitoring resource types, as expected by the /monitoring/resources endpoints.
+const (
+	MonitoringResourceHost    = "host"
+	MonitoringResourceService = "service"
+)
+
+// MonitoringResourceParent identifies the host of a service resource.
+type MonitoringResourceParent struct {
+	ID int `json:"id"`
+}
+
+// MonitoringResource identifies a host or a service in the real-time
+// monitoring API.
+type MonitoringResource struct {
+	Type   string                    `json:"type"`
+	ID     int                       `json:"id"`
+	Parent *MonitoringResourceParent `json:"parent"`
+}
+
+// NewMonitoringResource returns the resource of the given host, or of one of
+// its services when serviceID is not nil.
+func NewMonitoringResource(hostID int, serviceID *int) MonitoringResource {
+	if serviceID == nil {
+		return MonitoringResource{Type: MonitoringResourceHost, ID: hostID}
+	}
+	return MonitoringResource{
+		Type:   MonitoringResourceService,
+		ID:     *serviceID,
+		Parent: &MonitoringResourceParent{ID: hostID},
+	}
+}
+
+// Downtime is a downtime known to the real-time monitoring. Host downtimes
+// have no service ID.
+type Downtime struct {
+	ID           int        `json:"id"`
+	HostID       int        `json:"host_id"`
+	ServiceID    *int       `json:"service_id"`
+	AuthorName   *string    `json:"author_name"`
+	Comment      string     `json:"comment"`
+	StartTime    time.Time  `json:"start_time"`
+	EndTime      time.Time  `json:"end_time"`
+	Duration     int        `json:"duration"`
+	IsFixed      bool       `json:"is_fixed"`
+	IsCancelled  bool       `json:"is_cancelled"`
+	DeletionTime *time.Time `json:"deletion_time"`
+}
+
+// DowntimesResponse is a page of real-time downtimes.
+type DowntimesResponse struct {
+	Result []Downtime `json:"result"`
+	Meta   Meta       `json:"meta"`
+}
+
+// DowntimeRequest describes a downtime to schedule on monitoring resources.
+type DowntimeRequest struct {
+	Comment      string    `json:"comment"`
+	StartTime    time.Time `json:"start_time"`
+	EndTime      time.Time `json:"end_time"`
+	IsFixed      bool      `json:"is_fixed"`
+	Duration     int       `json:"duration"`
+	WithServices bool      `json:"with_services"`
+}
+
+// Acknowledgement is an acknowledgement known to the real-time monitoring.
+// Host acknowledgements have no service ID.
+type Acknowledgement struct {
+	ID                  int        `json:"id"`
+	HostID              int        `json:"host_id"`
+	ServiceID           *int       `json:"service_id"`
+	AuthorName          *string    `json:"author_name"`
+	Comment             string     `json:"comment"`
+	EntryTime           time.Time  `json:"entry_time"`
+	DeletionTime        *time.Time `json:"deletion_time"`
+	IsNotifyContacts    bool       `json:"is_notify_contacts"`
+	IsPersistentComment bool       `json:"is_persistent_comment"`
+	IsSticky            bool       `json:"is_sticky"`
+	State               int        `json:"state"`
+}
+
+// AcknowledgementsResponse is a page of real-time acknowledgements.
+type AcknowledgementsResponse struct {
+	Result []Acknowledgement `json:"result"`
+	Meta   Meta              `json:"meta"`
+}
+
+// AcknowledgementRequest describes an acknowledgement of monitoring resources
+// problems.
+type AcknowledgementRequest struct {
+	Comment             string `json:"comment"`
+	WithServices        bool   `json:"with_services"`
+	IsNotifyContacts    bool   `json:"is_notify_contacts"`
+	IsPersistentComment bool   `json:"is_persistent_comment"`
+	IsSticky            bool   `json:"is_sticky"`
+	ForceActiveChecks   bool   `json:"force_active_checks"`
+}
+
+// monitoringSearch builds an escaped search parameter matching the real-time
+// objects of the given host, or of one of its services.
+func monitoringSearch(hostID int, serviceID *int) string {
+	criteria := map[string]int{"host.id": hostID}
+	if serviceID != nil {
+		criteria["service.id"] = *serviceID
+	}
+	search, _ := json.Marshal(criteria)
+	return url.QueryEscape(string(search))
+}
+
+// AddDowntime schedules a downtime on the given resources. The API doesn't
+// return the created downtimes: they are listed by GetDowntimes once the
+// monitoring engine processed the command.
+func (c *Client) AddDowntime(downtime *DowntimeRequest, resources []MonitoringResource) error {
+	body := struct {
+		Downtime  *DowntimeRequest     `json:"downtime"`
+		Resources []MonitoringResource `json:"resources"`
+	}{downtime, resources}
+	return c.sendJSON("POST", fmt.Sprintf("%s/monitoring/resources/downtime", c.BaseURL), body, nil)
+}
+
+// GetDowntimes lists the downtimes of the given host and its services, or of
+// a single service when serviceID is not nil.
+func (c *Client) GetDowntimes(hostID int, serviceID *int) ([]Downtime, error) {
+	url := fmt.Sprintf("%s/monitoring/downtimes?limit=%d&page=%d&search=%s",
+		c.BaseURL, 1000, 1, monitoringSearch(hostID, serviceID))
+
+	var response DowntimesResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// GetDowntime retrieves the downtime with the given ID.
+func (c *Client) GetDowntime(id int) (*Downtime, error) {
+	var downtime Downtime
+	if err := c.getJSON(fmt.Sprintf("%s/monitoring/downtimes/%d", c.BaseURL, id), &downtime); err != nil {
+		return nil, err
+	}
+	return &downtime, nil
+}
+
+// CancelDowntime cancels the downtime with the given ID.
+func (c *Client) CancelDowntime(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/monitoring/downtimes/%d", c.BaseURL, id))
+}
+
+// Acknowledge acknowledges the problems of the given resources. The API
+// doesn't return the created acknowledgements: they are listed by
+// GetAcknowledgements once the monitoring engine processed the command.
+func (c *Client) Acknowledge(acknowledgement *AcknowledgementRequest, resources []MonitoringResource) error {
+	body := struct {
+		Acknowledgement *AcknowledgementRequest `json:"acknowledgement"`
+		Resources       []MonitoringResource    `json:"resources"`
+	}{acknowledgement, resources}
+	return c.sendJSON("POST", fmt.Sprintf("%s/monitoring/resources/acknowledge", c.BaseURL), body, nil)
+}
+
+// GetAcknowledgements lists the acknowledgements of the given host and its
+// services, or of a single service when serviceID is not nil.
+func (c *Client) GetAcknowledgements(hostID int, serviceID *int) ([]Acknowledgement, error) {
+	url := fmt.Sprintf("%s/monitoring/acknowledgements?limit=%d&page=%d&search=%s",
+		c.BaseURL, 1000, 1, monitoringSearch(hostID, serviceID))
+
+	var response AcknowledgementsResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// GetAcknowledgement retrieves the acknowledgement with the given ID.
+func (c *Client) GetAcknowledgement(id int) (*Acknowledgement, error) {
+	var acknowledgement Acknowledgement
+	if err := c.getJSON(fmt.Sprintf("%s/monitoring/acknowledgements/%d", c.BaseURL, id), &acknowledgement); err != nil {
+		return nil, err
+	}
+	return &acknowledgement, nil
+}
+
+// Disacknowledge removes the acknowledgements of the given resources, and of
+// the services of acknowledged hosts when withServices is true.
+func (c *Client) Disacknowledge(resources []MonitoringResource, withServices bool) error {
+	body := struct {
+		Disacknowledgement struct {
+			WithServices bool `json:"with_services"`
+		} `json:"disacknowledgement"`
+		Resources []MonitoringResource `json:"resources"`
+	}{Resources: resources}
+	body.Disacknowledgement.WithServices = withServices
+	return c.sendJSON("DELETE", fmt.Sprintf("%s/monitoring/resources/acknowledgements", c.BaseURL), body, nil)
+}
+
+// sameService reports whether a real-time object belongs to the given
+// service, or to the host itself when serviceID is nil.
+func sameService(objectServiceID, serviceID *int) bool {
+	if objectServiceID == nil || *objectServiceID == 0 {
+		return serviceID == nil
+	}
+	return serviceID != nil && *objectServiceID == *serviceID
+}
+
+// FindDowntime returns the latest active downtime scheduled by AddDowntime
+// with the given request on the host, or on one of its services.
+func (c *Client) FindDowntime(hostID int, serviceID *int, downtime *DowntimeRequest) (*Downtime, error) {
+	downtimes, err := c.GetDowntimes(hostID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Downtime
+	for i, d := range downtimes {
+		if d.IsCancelled || !sameService(d.ServiceID, serviceID) || d.Comment != downtime.Comment ||
+			!d.StartTime.Equal(downtime.StartTime) || !d.EndTime.Equal(downtime.EndTime) {
+			continue
+		}
+		if found == nil || d.ID > found.ID {
+			found = &downtimes[i]
+		}
+	}
+	if found == nil {
+		return nil, notFound("Downtime", downtime.Comment)
+	}
+	return found, nil
+}
+
+// FindAcknowledgement returns the latest active acknowledgement with the given
+// comment on the host, or on one of its services.
+func (c *Client) FindAcknowledgement(hostID int, serviceID *int, comment string) (*Acknowledgement, error) {
+	acknowledgements, err := c.GetAcknowledgements(hostID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Acknowledgement
+	for i, a := range acknowledgements {
+		if a.DeletionTime != nil || !sameService(a.ServiceID, serviceID) || a.Comment != comment {
+			continue
+		}
+		if found == nil || a.ID > found.ID {
+			found = &acknowledgements[i]
+		}
+	}
+	if found == nil {
+		return nil, notFound("Acknowledgement", comment)
+	}
+	return found, nil
+}
+
+// ResourceStatus is the current state of a monitoring resource, such as UP,
+// DOWN or PENDING for hosts and OK, WARNING or CRITICAL for services.
+type ResourceStatus struct {
+	Code         int    `json:"code"`
+	Name         string `json:"name"`
+	SeverityCode int    `json:"severity_code"`
+}
+
+// ResourceState is the real-time state of a host or a service. LastCheck is
+// nil until the resource was checked.
+type ResourceState struct {
+	ID               int            `json:"id"`
+	Type             string         `json:"type"`
+	Name             string         `json:"name"`
+	Status           ResourceStatus `json:"status"`
+	Information      string         `json:"information"`
+	LastCheck        *time.Time     `json:"last_check"`
+	LastStatusChange *time.Time     `json:"last_status_change"`
+	NextCheck        *time.Time     `json:"next_check"`
+	IsAcknowledged   bool           `json:"is_acknowledged"`
+	IsInDowntime     bool           `json:"is_in_downtime"`
+}
+
+// GetHostState retrieves the real-time state of the host with the given ID.
+func (c *Client) GetHostState(hostID int) (*ResourceState, error) {
+	var state ResourceState
+	if err := c.getJSON(fmt.Sprintf("%s/monitoring/resources/hosts/%d", c.BaseURL, hostID), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// GetServiceState retrieves the real-time state of the service with the given
+// ID on the given host.
+func (c *Client) GetServiceState(hostID, serviceID int) (*ResourceState, error) {
+	var state ResourceState
+	if err := c.getJSON(fmt.Sprintf("%s/monitoring/resources/hosts/%d/services/%d", c.BaseURL, hostID, serviceID), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
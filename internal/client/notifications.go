@@ -0,0 +1,85 @@
+package client
+
+import "fmt"
+
+// Notification resource types.
+const (
+	NotificationResourceHostGroup        = "hostgroup"
+	NotificationResourceServiceGroup     = "servicegroup"
+	NotificationResourceBusinessActivity = "ba"
+)
+
+// NotificationResourceExtra holds the service events notified for the
+// services of host group resources.
+type NotificationResourceExtra struct {
+	EventServices int `json:"event_services"`
+}
+
+// NotificationResource is a set of resources of a type notified on the
+// events of the Events bitmask.
+type NotificationResource struct {
+	Type   string                     `json:"type"`
+	Events int                        `json:"events"`
+	IDs    []int                      `json:"ids"`
+	Extra  *NotificationResourceExtra `json:"extra,omitempty"`
+}
+
+// NotificationMessage is the message sent on a notification channel.
+type NotificationMessage struct {
+	Channel string `json:"channel"`
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
+
+// Notification is a notification rule, notifying users and contact groups of
+// the events of its resources through its channels.
+type Notification struct {
+	ID            int                    `json:"id"`
+	Name          string                 `json:"name"`
+	TimePeriodID  int                    `json:"timeperiod_id"`
+	IsActivated   bool                   `json:"is_activated"`
+	Users         []int                  `json:"users"`
+	ContactGroups []int                  `json:"contactgroups"`
+	Resources     []NotificationResource `json:"resources"`
+	Messages      []NotificationMessage  `json:"messages"`
+}
+
+// NotificationRequest is the payload used to create or update a notification
+// rule.
+type NotificationRequest struct {
+	Name          string                 `json:"name"`
+	TimePeriodID  int                    `json:"timeperiod_id"`
+	IsActivated   bool                   `json:"is_activated"`
+	Users         []int                  `json:"users"`
+	ContactGroups []int                  `json:"contactgroups"`
+	Resources     []NotificationResource `json:"resources"`
+	Messages      []NotificationMessage  `json:"messages"`
+}
+
+// GetNotification retrieves the notification rule with the given ID.
+func (c *Client) GetNotification(id int) (*Notification, error) {
+	var notification Notification
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/notifications/%d", c.BaseURL, id), &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+// CreateNotification creates a notification rule and returns it.
+func (c *Client) CreateNotification(notification *NotificationRequest) (*Notification, error) {
+	var created Notification
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/notifications", c.BaseURL), notification, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateNotification replaces the notification rule with the given ID.
+func (c *Client) UpdateNotification(id int, notification *NotificationRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/notifications/%d", c.BaseURL, id), notification, nil)
+}
+
+// DeleteNotification deletes the notification rule with the given ID.
+func (c *Client) DeleteNotification(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/notifications/%d", c.BaseURL, id))
+}
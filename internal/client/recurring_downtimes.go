@@ -0,0 +1,70 @@
+package client
+
+import "fmt"
+
+// DowntimePeriod is a period of a recurring downtime. The month cycle selects
+// the days: "all" for weekly periods on DaysOfWeek, "none" for monthly periods
+// on DaysOfMonth, or "first" to "last" for the nth occurrence of the single
+// day of DaysOfWeek. Days of the week are numbered from 1 (monday) to 7.
+type DowntimePeriod struct {
+	StartTime   string `json:"start_time"`
+	EndTime     string `json:"end_time"`
+	Fixed       bool   `json:"fixed"`
+	Duration    *int   `json:"duration"`
+	DaysOfWeek  []int  `json:"days_of_week"`
+	DaysOfMonth []int  `json:"days_of_month"`
+	MonthCycle  string `json:"month_cycle"`
+}
+
+type RecurringDowntime struct {
+	ID            int                `json:"id"`
+	Name          string             `json:"name"`
+	Description   *string            `json:"description"`
+	IsActivated   bool               `json:"is_activated"`
+	Periods       []DowntimePeriod   `json:"periods"`
+	Hosts         []int              `json:"hosts"`
+	HostGroups    []int              `json:"host_groups"`
+	Services      []ServiceReference `json:"services"`
+	ServiceGroups []int              `json:"service_groups"`
+}
+
+// RecurringDowntimeRequest is the payload used to create or update a
+// recurring downtime.
+type RecurringDowntimeRequest struct {
+	Name          string             `json:"name"`
+	Description   *string            `json:"description"`
+	IsActivated   bool               `json:"is_activated"`
+	Periods       []DowntimePeriod   `json:"periods"`
+	Hosts         []int              `json:"hosts"`
+	HostGroups    []int              `json:"host_groups"`
+	Services      []ServiceReference `json:"services"`
+	ServiceGroups []int              `json:"service_groups"`
+}
+
+// GetRecurringDowntime retrieves the recurring downtime with the given ID.
+func (c *Client) GetRecurringDowntime(id int) (*RecurringDowntime, error) {
+	var downtime RecurringDowntime
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/recurring-downtimes/%d", c.BaseURL, id), &downtime); err != nil {
+		return nil, err
+	}
+	return &downtime, nil
+}
+
+// CreateRecurringDowntime creates a recurring downtime and returns it.
+func (c *Client) CreateRecurringDowntime(downtime *RecurringDowntimeRequest) (*RecurringDowntime, error) {
+	var created RecurringDowntime
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/recurring-downtimes", c.BaseURL), downtime, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRecurringDowntime replaces the recurring downtime with the given ID.
+func (c *Client) UpdateRecurringDowntime(id int, downtime *RecurringDowntimeRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/recurring-downtimes/%d", c.BaseURL, id), downtime, nil)
+}
+
+// DeleteRecurringDowntime deletes the recurring downtime with the given ID.
+func (c *Client) DeleteRecurringDowntime(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/recurring-downtimes/%d", c.BaseURL, id))
+}
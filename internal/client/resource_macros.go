@@ -0,0 +1,55 @@
+package client
+
+import "fmt"
+
+// ResourceMacro is a global $USERn$-style macro of the monitoring engine
+// resource file, defined for a set of monitoring servers. Password values are
+// masked by the API and returned as nil.
+type ResourceMacro struct {
+	ID                int     `json:"id"`
+	Name              string  `json:"name"`
+	Value             *string `json:"value"`
+	IsPassword        bool    `json:"is_password"`
+	Comment           *string `json:"comment"`
+	IsActivated       bool    `json:"is_activated"`
+	MonitoringServers []int   `json:"monitoring_servers"`
+}
+
+// ResourceMacroRequest is the payload used to create or update a resource
+// macro.
+type ResourceMacroRequest struct {
+	Name              string  `json:"name"`
+	Value             string  `json:"value"`
+	IsPassword        bool    `json:"is_password"`
+	Comment           *string `json:"comment"`
+	IsActivated       bool    `json:"is_activated"`
+	MonitoringServers []int   `json:"monitoring_servers"`
+}
+
+// GetResourceMacro retrieves the resource macro with the given ID.
+func (c *Client) GetResourceMacro(id int) (*ResourceMacro, error) {
+	var macro ResourceMacro
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/resource-macros/%d", c.BaseURL, id), &macro); err != nil {
+		return nil, err
+	}
+	return &macro, nil
+}
+
+// CreateResourceMacro creates a resource macro and returns it.
+func (c *Client) CreateResourceMacro(macro *ResourceMacroRequest) (*ResourceMacro, error) {
+	var created ResourceMacro
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/resource-macros", c.BaseURL), macro, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateResourceMacro replaces the resource macro with the given ID.
+func (c *Client) UpdateResourceMacro(id int, macro *ResourceMacroRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/resource-macros/%d", c.BaseURL, id), macro, nil)
+}
+
+// DeleteResourceMacro deletes the resource macro with the given ID.
+func (c *Client) DeleteResourceMacro(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/resource-macros/%d", c.BaseURL, id))
+}
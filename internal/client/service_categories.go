@@ -0,0 +1,62 @@
+package client
+
+import "fmt"
+
+type ServiceCategory struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	IsActivated bool   `json:"is_activated"`
+}
+
+type ServiceCategoriesResponse struct {
+	Result []ServiceCategory `json:"result"`
+	Meta   Meta              `json:"meta"`
+}
+
+// ServiceCategoryRequest is the payload used to create or update a service category.
+type ServiceCategoryRequest struct {
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	IsActivated bool   `json:"is_activated"`
+}
+
+// GetServiceCategories retrieves the service categories defined in the configuration.
+func (c *Client) GetServiceCategories(limit int, page int, search string) (*ServiceCategoriesResponse, error) {
+	url := fmt.Sprintf("%s/configuration/services/categories?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response ServiceCategoriesResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetServiceCategory retrieves the service category with the given ID.
+func (c *Client) GetServiceCategory(id int) (*ServiceCategory, error) {
+	var category ServiceCategory
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/services/categories/%d", c.BaseURL, id), &category); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// CreateServiceCategory creates a service category and returns it.
+func (c *Client) CreateServiceCategory(category *ServiceCategoryRequest) (*ServiceCategory, error) {
+	var created ServiceCategory
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/services/categories", c.BaseURL), category, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateServiceCategory replaces the service category with the given ID.
+func (c *Client) UpdateServiceCategory(id int, category *ServiceCategoryRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/services/categories/%d", c.BaseURL, id), category, nil)
+}
+
+// DeleteServiceCategory deletes the service category with the given ID.
+func (c *Client) DeleteServiceCategory(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/services/categories/%d", c.BaseURL, id))
+}
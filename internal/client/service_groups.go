@@ -0,0 +1,66 @@
+package client
+
+import "fmt"
+
+type ServiceGroup struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	GeoCoords   *string `json:"geo_coords"`
+	Comment     *string `json:"comment"`
+	IsActivated bool    `json:"is_activated"`
+}
+
+type ServiceGroupsResponse struct {
+	Result []ServiceGroup `json:"result"`
+	Meta   Meta           `json:"meta"`
+}
+
+// ServiceGroupRequest is the payload used to create or update a service group.
+type ServiceGroupRequest struct {
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	GeoCoords   *string `json:"geo_coords"`
+	Comment     *string `json:"comment"`
+	IsActivated bool    `json:"is_activated"`
+}
+
+// GetServiceGroups retrieves the service groups defined in the configuration.
+func (c *Client) GetServiceGroups(limit int, page int, search string) (*ServiceGroupsResponse, error) {
+	url := fmt.Sprintf("%s/configuration/services/groups?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response ServiceGroupsResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetServiceGroup retrieves the service group with the given ID.
+func (c *Client) GetServiceGroup(id int) (*ServiceGroup, error) {
+	var group ServiceGroup
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/services/groups/%d", c.BaseURL, id), &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CreateServiceGroup creates a service group and returns it.
+func (c *Client) CreateServiceGroup(group *ServiceGroupRequest) (*ServiceGroup, error) {
+	var created ServiceGroup
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/services/groups", c.BaseURL), group, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateServiceGroup replaces the service group with the given ID.
+func (c *Client) UpdateServiceGroup(id int, group *ServiceGroupRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/services/groups/%d", c.BaseURL, id), group, nil)
+}
+
+// DeleteServiceGroup deletes the service group with the given ID.
+func (c *Client) DeleteServiceGroup(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/services/groups/%d", c.BaseURL, id))
+}
@@ -0,0 +1,66 @@
+package client
+
+import "fmt"
+
+type ServiceSeverity struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	Level       int    `json:"level"`
+	IconID      int    `json:"icon_id"`
+	IsActivated bool   `json:"is_activated"`
+}
+
+type ServiceSeveritiesResponse struct {
+	Result []ServiceSeverity `json:"result"`
+	Meta   Meta              `json:"meta"`
+}
+
+// ServiceSeverityRequest is the payload used to create or update a service severity.
+type ServiceSeverityRequest struct {
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	Level       int    `json:"level"`
+	IconID      int    `json:"icon_id"`
+	IsActivated bool   `json:"is_activated"`
+}
+
+// GetServiceSeverities retrieves the service severities defined in the configuration.
+func (c *Client) GetServiceSeverities(limit int, page int, search string) (*ServiceSeveritiesResponse, error) {
+	url := fmt.Sprintf("%s/configuration/services/severities?limit=%d&page=%d&search=%s",
+		c.BaseURL, limit, page, search)
+
+	var response ServiceSeveritiesResponse
+	if err := c.getJSON(url, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetServiceSeverity retrieves the service severity with the given ID.
+func (c *Client) GetServiceSeverity(id int) (*ServiceSeverity, error) {
+	var severity ServiceSeverity
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/services/severities/%d", c.BaseURL, id), &severity); err != nil {
+		return nil, err
+	}
+	return &severity, nil
+}
+
+// CreateServiceSeverity creates a service severity and returns it.
+func (c *Client) CreateServiceSeverity(severity *ServiceSeverityRequest) (*ServiceSeverity, error) {
+	var created ServiceSeverity
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/services/severities", c.BaseURL), severity, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateServiceSeverity replaces the service severity with the given ID.
+func (c *Client) UpdateServiceSeverity(id int, severity *ServiceSeverityRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/services/severities/%d", c.BaseURL, id), severity, nil)
+}
+
+// DeleteServiceSeverity deletes the service severity with the given ID.
+func (c *Client) DeleteServiceSeverity(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/services/severities/%d", c.BaseURL, id))
+}
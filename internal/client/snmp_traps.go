@@ -0,0 +1,73 @@
+package client
+
+import "fmt"
+
+// SNMPTrapMatchingRule sets the status of a trap when Regexp matches String,
+// usually built from the trap arguments such as @OUTPUT@ or $1. Statuses are
+// service state codes, from 0 (OK) to 3 (UNKNOWN).
+type SNMPTrapMatchingRule struct {
+	String string `json:"string"`
+	Regexp string `json:"regexp"`
+	Status int    `json:"status"`
+	Order  int    `json:"order"`
+}
+
+// SNMPTrap defines how a received SNMP trap is turned into a check result of
+// the services created from the linked service templates.
+type SNMPTrap struct {
+	ID               int                    `json:"id"`
+	Name             string                 `json:"name"`
+	OID              string                 `json:"oid"`
+	VendorID         *int                   `json:"vendor_id"`
+	Output           string                 `json:"output"`
+	Status           int                    `json:"status"`
+	MatchingRules    []SNMPTrapMatchingRule `json:"matching_rules"`
+	SubmitResult     bool                   `json:"submit_result"`
+	RescheduleChecks bool                   `json:"reschedule_checks"`
+	ExecuteCommand   *string                `json:"execute_command"`
+	ServiceTemplates []int                  `json:"service_templates"`
+	Comment          *string                `json:"comment"`
+}
+
+// SNMPTrapRequest is the payload used to create or update an SNMP trap.
+type SNMPTrapRequest struct {
+	Name             string                 `json:"name"`
+	OID              string                 `json:"oid"`
+	VendorID         *int                   `json:"vendor_id"`
+	Output           string                 `json:"output"`
+	Status           int                    `json:"status"`
+	MatchingRules    []SNMPTrapMatchingRule `json:"matching_rules"`
+	SubmitResult     bool                   `json:"submit_result"`
+	RescheduleChecks bool                   `json:"reschedule_checks"`
+	ExecuteCommand   *string                `json:"execute_command"`
+	ServiceTemplates []int                  `json:"service_templates"`
+	Comment          *string                `json:"comment"`
+}
+
+// GetSNMPTrap retrieves the SNMP trap with the given ID.
+func (c *Client) GetSNMPTrap(id int) (*SNMPTrap, error) {
+	var trap SNMPTrap
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/snmp-traps/%d", c.BaseURL, id), &trap); err != nil {
+		return nil, err
+	}
+	return &trap, nil
+}
+
+// CreateSNMPTrap creates an SNMP trap and returns it.
+func (c *Client) CreateSNMPTrap(trap *SNMPTrapRequest) (*SNMPTrap, error) {
+	var created SNMPTrap
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/snmp-traps", c.BaseURL), trap, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateSNMPTrap replaces the SNMP trap with the given ID.
+func (c *Client) UpdateSNMPTrap(id int, trap *SNMPTrapRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/snmp-traps/%d", c.BaseURL, id), trap, nil)
+}
+
+// DeleteSNMPTrap deletes the SNMP trap with the given ID.
+func (c *Client) DeleteSNMPTrap(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/snmp-traps/%d", c.BaseURL, id))
+}
@@ -0,0 +1,59 @@
+package client
+
+import "fmt"
+
+// User is a Centreon user, also known as a contact. Passwords are never
+// returned by the API.
+type User struct {
+	ID                       int    `json:"id"`
+	Name                     string `json:"name"`
+	Alias                    string `json:"alias"`
+	Email                    string `json:"email"`
+	IsAdmin                  bool   `json:"is_admin"`
+	IsActivated              bool   `json:"is_activated"`
+	CanReachFrontend         bool   `json:"can_reach_frontend"`
+	CanReachConfigurationAPI bool   `json:"can_reach_configuration_api"`
+	CanReachRealtimeAPI      bool   `json:"can_reach_realtime_api"`
+}
+
+// UserRequest is the payload used to create or update a user. The password
+// is left unchanged on update when nil.
+type UserRequest struct {
+	Name                     string  `json:"name"`
+	Alias                    string  `json:"alias"`
+	Email                    string  `json:"email"`
+	Password                 *string `json:"password,omitempty"`
+	IsAdmin                  bool    `json:"is_admin"`
+	IsActivated              bool    `json:"is_activated"`
+	CanReachFrontend         bool    `json:"can_reach_frontend"`
+	CanReachConfigurationAPI bool    `json:"can_reach_configuration_api"`
+	CanReachRealtimeAPI      bool    `json:"can_reach_realtime_api"`
+}
+
+// GetUser retrieves the user with the given ID.
+func (c *Client) GetUser(id int) (*User, error) {
+	var user User
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/users/%d", c.BaseURL, id), &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreateUser creates a user and returns it.
+func (c *Client) CreateUser(user *UserRequest) (*User, error) {
+	var created User
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/users", c.BaseURL), user, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateUser replaces the user with the given ID.
+func (c *Client) UpdateUser(id int, user *UserRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/users/%d", c.BaseURL, id), user, nil)
+}
+
+// DeleteUser deletes the user with the given ID.
+func (c *Client) DeleteUser(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/users/%d", c.BaseURL, id))
+}
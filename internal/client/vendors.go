@@ -0,0 +1,46 @@
+package client
+
+import "fmt"
+
+// Vendor is a manufacturer of SNMP devices, grouping SNMP traps.
+type Vendor struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	Description *string `json:"description"`
+}
+
+// VendorRequest is the payload used to create or update a vendor.
+type VendorRequest struct {
+	Name        string  `json:"name"`
+	Alias       string  `json:"alias"`
+	Description *string `json:"description"`
+}
+
+// GetVendor retrieves the vendor with the given ID.
+func (c *Client) GetVendor(id int) (*Vendor, error) {
+	var vendor Vendor
+	if err := c.getJSON(fmt.Sprintf("%s/configuration/vendors/%d", c.BaseURL, id), &vendor); err != nil {
+		return nil, err
+	}
+	return &vendor, nil
+}
+
+// CreateVendor creates a vendor and returns it.
+func (c *Client) CreateVendor(vendor *VendorRequest) (*Vendor, error) {
+	var created Vendor
+	if err := c.sendJSON("POST", fmt.Sprintf("%s/configuration/vendors", c.BaseURL), vendor, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateVendor replaces the vendor with the given ID.
+func (c *Client) UpdateVendor(id int, vendor *VendorRequest) error {
+	return c.sendJSON("PUT", fmt.Sprintf("%s/configuration/vendors/%d", c.BaseURL, id), vendor, nil)
+}
+
+// DeleteVendor deletes the vendor with the given ID.
+func (c *Client) DeleteVendor(id int) error {
+	return c.deleteObject(fmt.Sprintf("%s/configuration/vendors/%d", c.BaseURL, id))
+}
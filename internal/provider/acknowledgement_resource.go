@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &acknowledgementResource{}
+	_ resource.ResourceWithImportState      = &acknowledgementResource{}
+	_ resource.ResourceWithConfigValidators = &acknowledgementResource{}
+)
+
+func NewAcknowledgementResource() resource.Resource {
+	return &acknowledgementResource{}
+}
+
+type acknowledgementResource struct {
+	client *client.Client
+}
+
+type acknowledgementResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	HostID            types.Int64  `tfsdk:"host_id"`
+	ServiceID         types.Int64  `tfsdk:"service_id"`
+	Comment           types.String `tfsdk:"comment"`
+	WithServices      types.Bool   `tfsdk:"with_services"`
+	NotifyContacts    types.Bool   `tfsdk:"notify_contacts"`
+	PersistentComment types.Bool   `tfsdk:"persistent_comment"`
+	Sticky            types.Bool   `tfsdk:"sticky"`
+}
+
+func (r *acknowledgementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acknowledgement"
+}
+
+func (r *acknowledgementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Acknowledges the problem of a host or a service through the real-time monitoring API. " +
+			"The acknowledgement is removed on destroy. Once the problem recovers, the acknowledgement disappears " +
+			"and Terraform plans to create it again. Acknowledgements can't be modified, so any change replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Acknowledgement ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the acknowledged host, or of the host of the acknowledged service",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the acknowledged service. The host itself is acknowledged when not set",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Required:    true,
+				Description: "Comment of the acknowledgement",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"with_services": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the problems of the services of the host are acknowledged too",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"notify_contacts": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the contacts are notified of the acknowledgement",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"persistent_comment": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the comment is kept after the monitoring engine restarts",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sticky": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the acknowledgement is kept until the problem recovers, rather than until the next state change",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *acknowledgementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *acknowledgementResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validation.ConflictingAttributesValidator{
+			Paths: []path.Path{path.Root("service_id"), path.Root("with_services")},
+		},
+	}
+}
+
+// request builds the API payload from the plan.
+func (m acknowledgementResourceModel) request() *client.AcknowledgementRequest {
+	return &client.AcknowledgementRequest{
+		Comment:             m.Comment.ValueString(),
+		WithServices:        m.WithServices.ValueBool(),
+		IsNotifyContacts:    m.NotifyContacts.ValueBool(),
+		IsPersistentComment: m.PersistentComment.ValueBool(),
+		IsSticky:            m.Sticky.ValueBool(),
+	}
+}
+
+// resource returns the acknowledged host or service.
+func (m acknowledgementResourceModel) resource() client.MonitoringResource {
+	return client.NewMonitoringResource(int(m.HostID.ValueInt64()), optionalInt64(m.ServiceID))
+}
+
+// refresh updates the model with the acknowledgement returned by the API.
+func (m *acknowledgementResourceModel) refresh(acknowledgement *client.Acknowledgement) {
+	m.ID = types.Int64Value(int64(acknowledgement.ID))
+	m.HostID = types.Int64Value(int64(acknowledgement.HostID))
+	m.ServiceID = types.Int64Null()
+	if acknowledgement.ServiceID != nil && *acknowledgement.ServiceID != 0 {
+		m.ServiceID = types.Int64Value(int64(*acknowledgement.ServiceID))
+	}
+	m.Comment = types.StringValue(acknowledgement.Comment)
+	m.NotifyContacts = types.BoolValue(acknowledgement.IsNotifyContacts)
+	m.PersistentComment = types.BoolValue(acknowledgement.IsPersistentComment)
+	m.Sticky = types.BoolValue(acknowledgement.IsSticky)
+	if m.WithServices.IsNull() {
+		m.WithServices = types.BoolValue(false)
+	}
+}
+
+func (r *acknowledgementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan acknowledgementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostID := int(plan.HostID.ValueInt64())
+	logging.Info(ctx, "Creating acknowledgement", map[string]interface{}{
+		"host_id": hostID,
+		"comment": plan.Comment.ValueString(),
+	})
+
+	if err := r.client.Acknowledge(plan.request(), []client.MonitoringResource{plan.resource()}); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating acknowledgement",
+			fmt.Sprintf("Could not create acknowledgement: %v", err),
+		)
+		return
+	}
+
+	var acknowledgement *client.Acknowledgement
+	err := waitFor(ctx, monitoringTimeout, monitoringPollInterval, func() (bool, error) {
+		var err error
+		acknowledgement, err = r.client.FindAcknowledgement(hostID, optionalInt64(plan.ServiceID), plan.Comment.ValueString())
+		return err == nil, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating acknowledgement",
+			fmt.Sprintf("The acknowledgement was sent but isn't listed by the monitoring: %v", err),
+		)
+		return
+	}
+	plan.refresh(acknowledgement)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read removes acknowledgements that were removed, by hand or on recovery,
+// from the state.
+func (r *acknowledgementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state acknowledgementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acknowledgement, err := r.client.GetAcknowledgement(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading acknowledgement",
+			fmt.Sprintf("Could not read acknowledgement %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	if acknowledgement.DeletionTime != nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.refresh(acknowledgement)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update only stores the plan: every attribute of an acknowledgement forces
+// its replacement.
+func (r *acknowledgementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan acknowledgementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *acknowledgementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state acknowledgementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Disacknowledge([]client.MonitoringResource{state.resource()}, state.WithServices.ValueBool())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting acknowledgement",
+			fmt.Sprintf("Could not remove acknowledgement %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+}
+
+func (r *acknowledgementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &aclActionAccessResource{}
+	_ resource.ResourceWithImportState = &aclActionAccessResource{}
+)
+
+func NewACLActionAccessResource() resource.Resource {
+	return &aclActionAccessResource{}
+}
+
+type aclActionAccessResource struct {
+	client *client.Client
+}
+
+type aclActionAccessResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	Actions     types.Set    `tfsdk:"actions"`
+}
+
+func (r *aclActionAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_action_access"
+}
+
+func (r *aclActionAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon ACL action access, listing the monitoring actions allowed to the ACL groups it is granted to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ACL action access ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "ACL action access name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "ACL action access description",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the ACL action access is enabled",
+			},
+			"actions": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Allowed actions, among: %s", strings.Join(validation.ACLActionNames(), ", ")),
+				Validators: []validator.Set{
+					validation.ACLActionsValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *aclActionAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan.
+func (m aclActionAccessResourceModel) request(ctx context.Context) (*client.ACLActionAccessRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var names []string
+	diags.Append(m.Actions.ElementsAs(ctx, &names, false)...)
+	actions := []string{}
+	for _, name := range names {
+		action, err := validation.ParseACLAction(name)
+		if err != nil {
+			diags.AddAttributeError(path.Root("actions"), "Invalid ACL Action", err.Error())
+			continue
+		}
+		actions = append(actions, string(action))
+	}
+
+	return &client.ACLActionAccessRequest{
+		Name:        m.Name.ValueString(),
+		Description: optionalString(m.Description),
+		IsActivated: m.IsActivated.ValueBool(),
+		Actions:     actions,
+	}, diags
+}
+
+// refresh updates the model with the ACL action access returned by the API.
+func (m *aclActionAccessResourceModel) refresh(access *client.ACLActionAccess) {
+	m.ID = types.Int64Value(int64(access.ID))
+	m.Name = types.StringValue(access.Name)
+	m.Description = stringFromAPI(access.Description, m.Description)
+	m.IsActivated = types.BoolValue(access.IsActivated)
+	m.Actions = setFromStrings(access.Actions)
+}
+
+func (r *aclActionAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aclActionAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating ACL action access", map[string]interface{}{
+		"name": accessReq.Name,
+	})
+
+	access, err := r.client.CreateACLActionAccess(accessReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ACL action access",
+			fmt.Sprintf("Could not create ACL action access: %v", err),
+		)
+		return
+	}
+	plan.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclActionAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aclActionAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, err := r.client.GetACLActionAccess(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL action access",
+			fmt.Sprintf("Could not read ACL action access %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *aclActionAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan aclActionAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateACLActionAccess(id, accessReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL action access",
+			fmt.Sprintf("Could not update ACL action access %d: %v", id, err),
+		)
+		return
+	}
+
+	access, err := r.client.GetACLActionAccess(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL action access",
+			fmt.Sprintf("Could not read ACL action access %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclActionAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aclActionAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteACLActionAccess(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL action access",
+			fmt.Sprintf("Could not delete ACL action access %d: %v", id, err),
+		)
+		return
+	}
+}
+
+func (r *aclActionAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &aclGroupResource{}
+	_ resource.ResourceWithImportState = &aclGroupResource{}
+)
+
+func NewACLGroupResource() resource.Resource {
+	return &aclGroupResource{}
+}
+
+type aclGroupResource struct {
+	client *client.Client
+}
+
+type aclGroupResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Alias             types.String `tfsdk:"alias"`
+	IsActivated       types.Bool   `tfsdk:"is_activated"`
+	ContactIDs        types.Set    `tfsdk:"contact_ids"`
+	ContactGroupIDs   types.Set    `tfsdk:"contact_group_ids"`
+	ResourceAccessIDs types.Set    `tfsdk:"resource_access_ids"`
+	MenuAccessIDs     types.Set    `tfsdk:"menu_access_ids"`
+	ActionAccessIDs   types.Set    `tfsdk:"action_access_ids"`
+}
+
+func (r *aclGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_group"
+}
+
+func (r *aclGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon ACL group, granting its contacts and contact groups the resources, menus and actions of its access lists.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ACL group ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "ACL group name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ACL group alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the ACL group is enabled",
+			},
+			"contact_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the contacts of the group",
+			},
+			"contact_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the contact groups of the group",
+			},
+			"resource_access_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the ACL resource accesses granted to the group",
+			},
+			"menu_access_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the ACL menu accesses granted to the group",
+			},
+			"action_access_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the ACL action accesses granted to the group",
+			},
+		},
+	}
+}
+
+func (r *aclGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m aclGroupResourceModel) request(ctx context.Context) (*client.ACLGroupRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	contacts, d := intsFromSet(ctx, m.ContactIDs)
+	diags.Append(d...)
+	contactGroups, d := intsFromSet(ctx, m.ContactGroupIDs)
+	diags.Append(d...)
+	resourceAccesses, d := intsFromSet(ctx, m.ResourceAccessIDs)
+	diags.Append(d...)
+	menuAccesses, d := intsFromSet(ctx, m.MenuAccessIDs)
+	diags.Append(d...)
+	actionAccesses, d := intsFromSet(ctx, m.ActionAccessIDs)
+	diags.Append(d...)
+
+	return &client.ACLGroupRequest{
+		Name:             m.Name.ValueString(),
+		Alias:            alias,
+		IsActivated:      m.IsActivated.ValueBool(),
+		Contacts:         nonNilInts(contacts),
+		ContactGroups:    nonNilInts(contactGroups),
+		ResourceAccesses: nonNilInts(resourceAccesses),
+		MenuAccesses:     nonNilInts(menuAccesses),
+		ActionAccesses:   nonNilInts(actionAccesses),
+	}, diags
+}
+
+// refresh updates the model with the ACL group returned by the API.
+func (m *aclGroupResourceModel) refresh(group *client.ACLGroup) {
+	m.ID = types.Int64Value(int64(group.ID))
+	m.Name = types.StringValue(group.Name)
+	m.Alias = types.StringValue(group.Alias)
+	m.IsActivated = types.BoolValue(group.IsActivated)
+	m.ContactIDs = optionalSetFromInts(group.Contacts, m.ContactIDs)
+	m.ContactGroupIDs = optionalSetFromInts(group.ContactGroups, m.ContactGroupIDs)
+	m.ResourceAccessIDs = optionalSetFromInts(group.ResourceAccesses, m.ResourceAccessIDs)
+	m.MenuAccessIDs = optionalSetFromInts(group.MenuAccesses, m.MenuAccessIDs)
+	m.ActionAccessIDs = optionalSetFromInts(group.ActionAccesses, m.ActionAccessIDs)
+}
+
+func (r *aclGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aclGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating ACL group", map[string]interface{}{
+		"name": groupReq.Name,
+	})
+
+	group, err := r.client.CreateACLGroup(groupReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ACL group",
+			fmt.Sprintf("Could not create ACL group: %v", err),
+		)
+		return
+	}
+	plan.refresh(group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aclGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetACLGroup(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL group",
+			fmt.Sprintf("Could not read ACL group %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *aclGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan aclGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateACLGroup(id, groupReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL group",
+			fmt.Sprintf("Could not update ACL group %d: %v", id, err),
+		)
+		return
+	}
+
+	group, err := r.client.GetACLGroup(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL group",
+			fmt.Sprintf("Could not read ACL group %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aclGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteACLGroup(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL group",
+			fmt.Sprintf("Could not delete ACL group %d: %v", id, err),
+		)
+		return
+	}
+}
+
+func (r *aclGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &aclResourceAccessResource{}
+	_ resource.ResourceWithImportState    = &aclResourceAccessResource{}
+	_ resource.ResourceWithValidateConfig = &aclResourceAccessResource{}
+)
+
+func NewACLResourceAccessResource() resource.Resource {
+	return &aclResourceAccessResource{}
+}
+
+type aclResourceAccessResource struct {
+	client *client.Client
+}
+
+type aclResourceAccessResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	IsActivated         types.Bool   `tfsdk:"is_activated"`
+	AllHosts            types.Bool   `tfsdk:"all_hosts"`
+	AllHostGroups       types.Bool   `tfsdk:"all_host_groups"`
+	AllServiceGroups    types.Bool   `tfsdk:"all_service_groups"`
+	HostIDs             types.Set    `tfsdk:"host_ids"`
+	ExcludedHostIDs     types.Set    `tfsdk:"excluded_host_ids"`
+	HostGroupIDs        types.Set    `tfsdk:"host_group_ids"`
+	ServiceGroupIDs     types.Set    `tfsdk:"service_group_ids"`
+	HostCategoryIDs     types.Set    `tfsdk:"host_category_ids"`
+	ServiceCategoryIDs  types.Set    `tfsdk:"service_category_ids"`
+	MonitoringServerIDs types.Set    `tfsdk:"monitoring_server_ids"`
+	MetaServiceIDs      types.Set    `tfsdk:"meta_service_ids"`
+}
+
+func (r *aclResourceAccessResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_resource_access"
+}
+
+func (r *aclResourceAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon ACL resource access, listing the resources visible to the ACL groups it is granted to. " +
+			"Categories and monitoring servers filter the hosts and services selected by the other attributes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ACL resource access ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "ACL resource access name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "ACL resource access description",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the ACL resource access is enabled",
+			},
+			"all_hosts": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether all the hosts are included, including the ones created later",
+			},
+			"all_host_groups": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether all the host groups are included, including the ones created later",
+			},
+			"all_service_groups": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether all the service groups are included, including the ones created later",
+			},
+			"host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the included hosts",
+			},
+			"excluded_host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the hosts excluded from all_hosts and from the included host groups",
+			},
+			"host_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the included host groups",
+			},
+			"service_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the included service groups",
+			},
+			"host_category_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the host categories the included hosts are filtered by",
+			},
+			"service_category_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the service categories the included services are filtered by",
+			},
+			"monitoring_server_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the monitoring servers the included hosts are filtered by",
+			},
+			"meta_service_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the included meta services",
+			},
+		},
+	}
+}
+
+func (r *aclResourceAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that hosts, host groups and service groups aren't
+// listed along with the matching "all" flag.
+func (r *aclResourceAccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config aclResourceAccessResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMembers(config.HostIDs, config.AllHosts, path.Root("host_ids"), "Hosts", &resp.Diagnostics)
+	validateMembers(config.HostGroupIDs, config.AllHostGroups, path.Root("host_group_ids"), "Host groups", &resp.Diagnostics)
+	validateMembers(config.ServiceGroupIDs, config.AllServiceGroups, path.Root("service_group_ids"), "Service groups", &resp.Diagnostics)
+}
+
+// request builds the API payload from the plan.
+func (m aclResourceAccessResourceModel) request(ctx context.Context) (*client.ACLResourceAccessRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hosts, d := intsFromSet(ctx, m.HostIDs)
+	diags.Append(d...)
+	excludedHosts, d := intsFromSet(ctx, m.ExcludedHostIDs)
+	diags.Append(d...)
+	hostGroups, d := intsFromSet(ctx, m.HostGroupIDs)
+	diags.Append(d...)
+	serviceGroups, d := intsFromSet(ctx, m.ServiceGroupIDs)
+	diags.Append(d...)
+	hostCategories, d := intsFromSet(ctx, m.HostCategoryIDs)
+	diags.Append(d...)
+	serviceCategories, d := intsFromSet(ctx, m.ServiceCategoryIDs)
+	diags.Append(d...)
+	monitoringServers, d := intsFromSet(ctx, m.MonitoringServerIDs)
+	diags.Append(d...)
+	metaServices, d := intsFromSet(ctx, m.MetaServiceIDs)
+	diags.Append(d...)
+
+	return &client.ACLResourceAccessRequest{
+		Name:              m.Name.ValueString(),
+		Description:       optionalString(m.Description),
+		IsActivated:       m.IsActivated.ValueBool(),
+		AllHosts:          m.AllHosts.ValueBool(),
+		AllHostGroups:     m.AllHostGroups.ValueBool(),
+		AllServiceGroups:  m.AllServiceGroups.ValueBool(),
+		Hosts:             nonNilInts(hosts),
+		ExcludedHosts:     nonNilInts(excludedHosts),
+		HostGroups:        nonNilInts(hostGroups),
+		ServiceGroups:     nonNilInts(serviceGroups),
+		HostCategories:    nonNilInts(hostCategories),
+		ServiceCategories: nonNilInts(serviceCategories),
+		MonitoringServers: nonNilInts(monitoringServers),
+		MetaServices:      nonNilInts(metaServices),
+	}, diags
+}
+
+// refresh updates the model with the ACL resource access returned by the API.
+func (m *aclResourceAccessResourceModel) refresh(access *client.ACLResourceAccess) {
+	m.ID = types.Int64Value(int64(access.ID))
+	m.Name = types.StringValue(access.Name)
+	m.Description = stringFromAPI(access.Description, m.Description)
+	m.IsActivated = types.BoolValue(access.IsActivated)
+	m.AllHosts = types.BoolValue(access.AllHosts)
+	m.AllHostGroups = types.BoolValue(access.AllHostGroups)
+	m.AllServiceGroups = types.BoolValue(access.AllServiceGroups)
+	m.HostIDs = optionalSetFromInts(access.Hosts, m.HostIDs)
+	m.ExcludedHostIDs = optionalSetFromInts(access.ExcludedHosts, m.ExcludedHostIDs)
+	m.HostGroupIDs = optionalSetFromInts(access.HostGroups, m.HostGroupIDs)
+	m.ServiceGroupIDs = optionalSetFromInts(access.ServiceGroups, m.ServiceGroupIDs)
+	m.HostCategoryIDs = optionalSetFromInts(access.HostCategories, m.HostCategoryIDs)
+	m.ServiceCategoryIDs = optionalSetFromInts(access.ServiceCategories, m.ServiceCategoryIDs)
+	m.MonitoringServerIDs = optionalSetFromInts(access.MonitoringServers, m.MonitoringServerIDs)
+	m.MetaServiceIDs = optionalSetFromInts(access.MetaServices, m.MetaServiceIDs)
+}
+
+func (r *aclResourceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan aclResourceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating ACL resource access", map[string]interface{}{
+		"name": accessReq.Name,
+	})
+
+	access, err := r.client.CreateACLResourceAccess(accessReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ACL resource access",
+			fmt.Sprintf("Could not create ACL resource access: %v", err),
+		)
+		return
+	}
+	plan.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclResourceAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state aclResourceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	access, err := r.client.GetACLResourceAccess(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL resource access",
+			fmt.Sprintf("Could not read ACL resource access %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *aclResourceAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan aclResourceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accessReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateACLResourceAccess(id, accessReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ACL resource access",
+			fmt.Sprintf("Could not update ACL resource access %d: %v", id, err),
+		)
+		return
+	}
+
+	access, err := r.client.GetACLResourceAccess(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ACL resource access",
+			fmt.Sprintf("Could not read ACL resource access %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(access)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *aclResourceAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state aclResourceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteACLResourceAccess(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting ACL resource access",
+			fmt.Sprintf("Could not delete ACL resource access %d: %v", id, err),
+		)
+		return
+	}
+}
+
+func (r *aclResourceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
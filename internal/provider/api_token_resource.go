@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &apiTokenResource{}
+	_ resource.ResourceWithImportState = &apiTokenResource{}
+	_ resource.ResourceWithModifyPlan  = &apiTokenResource{}
+)
+
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+type apiTokenResource struct {
+	client *client.Client
+}
+
+type apiTokenResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	UserID          types.Int64  `tfsdk:"user_id"`
+	ExpirationDate  types.String `tfsdk:"expiration_date"`
+	CreationDate    types.String `tfsdk:"creation_date"`
+	Token           types.String `tfsdk:"token"`
+	DeleteOnDestroy types.Bool   `tfsdk:"delete_on_destroy"`
+}
+
+func (r *apiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API token of a Centreon user. The token is revoked on destroy, or deleted when `delete_on_destroy` is set. " +
+			"Its value is only returned on creation, so imported tokens have no value, and any change replaces the token. " +
+			"Revoked tokens are removed from the state and created again, expired tokens are kept until their expiration date changes. " +
+			"Token names are unique for a user, revoked tokens included, so replacing a token requires a new name unless `delete_on_destroy` is set, " +
+			"and `create_before_destroy` always requires a new name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Token identifier, made of the user ID and the token name separated by a slash",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Token name, unique for the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the user the token authenticates as",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Required:    true,
+				Description: "Expiration date of the token, in RFC 3339 format",
+				Validators: []validator.String{
+					validation.RFC3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date of the token, in RFC 3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Token value, to be sent in the X-AUTH-TOKEN header",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to delete the token on destroy instead of revoking it. Revoked tokens are kept by Centreon and their name can't be reused until they are deleted. Defaults to false",
+			},
+		},
+	}
+}
+
+func (r *apiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// refresh updates the model with the token returned by the API. The token
+// value is only returned on creation, so the current value is kept otherwise.
+func (m *apiTokenResourceModel) refresh(token *client.APIToken) {
+	m.ID = types.StringValue(fmt.Sprintf("%d/%s", token.User.ID, token.Name))
+	m.Name = types.StringValue(token.Name)
+	m.UserID = types.Int64Value(int64(token.User.ID))
+	m.ExpirationDate = timeFromAPI(token.ExpirationDate, m.ExpirationDate)
+	m.CreationDate = timeFromAPI(token.CreationDate, m.CreationDate)
+	if token.Token != nil {
+		m.Token = types.StringValue(*token.Token)
+	}
+}
+
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiration, err := time.Parse(time.RFC3339, plan.ExpirationDate.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			fmt.Sprintf("invalid expiration_date: %v", err),
+		)
+		return
+	}
+
+	// Token names are unique for a user, revoked and expired tokens included,
+	// and tokens not managed by Terraform are left alone
+	resp.Diagnostics.Append(r.checkExistingToken(plan.Name.ValueString(), int(plan.UserID.ValueInt64()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating API token", map[string]interface{}{
+		"name":    plan.Name.ValueString(),
+		"user_id": plan.UserID.ValueInt64(),
+	})
+
+	token, err := r.client.CreateAPIToken(&client.APITokenRequest{
+		Name:           plan.Name.ValueString(),
+		UserID:         int(plan.UserID.ValueInt64()),
+		ExpirationDate: expiration,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			fmt.Sprintf("Could not create API token: %v", err),
+		)
+		return
+	}
+	if token.Token == nil {
+		resp.Diagnostics.AddError(
+			"Error after creating API token",
+			fmt.Sprintf("The API didn't return the value of API token %s", token.Name),
+		)
+		return
+	}
+	plan.refresh(token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan rejects expiration dates in the past for tokens that are created,
+// which the API would refuse.
+func (r *apiTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan apiTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tokens that are kept may expire, only new tokens are checked
+	if !req.State.Raw.IsNull() {
+		var state apiTokenResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Name.Equal(state.Name) && plan.UserID.Equal(state.UserID) && plan.ExpirationDate.Equal(state.ExpirationDate) {
+			return
+		}
+	}
+
+	if plan.ExpirationDate.IsUnknown() {
+		return
+	}
+	expiration, err := time.Parse(time.RFC3339, plan.ExpirationDate.ValueString())
+	if err != nil {
+		// Reported by the validator of the attribute
+		return
+	}
+	if !expiration.After(time.Now()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration_date"),
+			"Expired API Token",
+			fmt.Sprintf("The expiration date %s is in the past, set a later date to create the token.", plan.ExpirationDate.ValueString()),
+		)
+	}
+}
+
+// checkExistingToken fails when the user already has a token with the given
+// name, which would make the creation fail.
+func (r *apiTokenResource) checkExistingToken(name string, userID int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	token, err := r.client.GetAPIToken(name, userID)
+	if client.IsNotFound(err) {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error creating API token",
+			fmt.Sprintf("Could not read API token %d/%s: %v", userID, name, err),
+		)
+		return diags
+	}
+
+	if token.IsRevoked {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"API Token Already Exists",
+			fmt.Sprintf("User %d already has a revoked API token named %s. Delete it in Centreon, or choose another name.", userID, name),
+		)
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("name"),
+		"API Token Already Exists",
+		fmt.Sprintf("User %d already has an API token named %s. Import it with the ID %d/%s to manage it, or choose another name.",
+			userID, name, userID, name),
+	)
+	return diags
+}
+
+// Read removes revoked tokens from the state, so that they are created again.
+// Expired tokens are kept, creating them again with the same expiration date
+// would fail.
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.GetAPIToken(state.Name.ValueString(), int(state.UserID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading API token",
+			fmt.Sprintf("Could not read API token %s: %v", state.ID.ValueString(), err),
+		)
+		return
+	}
+	if token.IsRevoked {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.refresh(token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update only stores the plan: every attribute of an API token forces its
+// replacement.
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan apiTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, userID := state.Name.ValueString(), int(state.UserID.ValueInt64())
+	if state.DeleteOnDestroy.ValueBool() {
+		err := r.client.DeleteAPIToken(name, userID)
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting API token",
+				fmt.Sprintf("Could not delete API token %s: %v", state.ID.ValueString(), err),
+			)
+		}
+		return
+	}
+
+	err := r.client.RevokeAPIToken(name, userID)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting API token",
+			fmt.Sprintf("Could not revoke API token %s: %v", state.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// ImportState imports a token by its user ID and name separated by a slash.
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, name, found := strings.Cut(req.ID, "/")
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if !found || err != nil || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a user ID and a token name separated by a slash, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(name))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), types.Int64Value(id))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_on_destroy"), types.BoolValue(false))...)
+}
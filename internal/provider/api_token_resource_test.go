@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAPITokenModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &apiTokenResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// token returns a token of user 1 with the given name and expiration date
+	token := func(name string, expiration interface{}) tftypes.Value {
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		attributes["name"] = tftypes.NewValue(tftypes.String, name)
+		attributes["user_id"] = tftypes.NewValue(tftypes.Number, 1)
+		attributes["expiration_date"] = tftypes.NewValue(tftypes.String, expiration)
+		return tftypes.NewValue(objectType, attributes)
+	}
+	past, future := "2020-01-01T00:00:00Z", "2999-01-01T00:00:00Z"
+	noState := tftypes.NewValue(objectType, nil)
+
+	tests := []struct {
+		name    string
+		state   tftypes.Value
+		plan    tftypes.Value
+		wantErr bool
+	}{
+		{name: "new token", state: noState, plan: token("ci", future)},
+		{name: "new expired token", state: noState, plan: token("ci", past), wantErr: true},
+		{name: "unknown expiration", state: noState, plan: token("ci", tftypes.UnknownValue)},
+		{name: "kept expired token", state: token("ci", past), plan: token("ci", past)},
+		{name: "replaced by an expired token", state: token("ci", past), plan: token("ci-2", past), wantErr: true},
+		{name: "destroyed token", state: token("ci", past), plan: noState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tt.plan},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAPITokenImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &apiTokenResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		id       string
+		wantName string
+		wantErr  bool
+	}{
+		{id: "21/terraform", wantName: "terraform"},
+		{id: "21/ci/2027", wantName: "ci/2027"},
+		{id: "terraform", wantErr: true},
+		{id: "admin/terraform", wantErr: true},
+		{id: "21/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var state apiTokenResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if state.Name.ValueString() != tt.wantName || state.UserID.ValueInt64() != 21 || state.ID.ValueString() != tt.id {
+				t.Errorf("imported %v, want token %s of user 21", state, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &connectorResource{}
+	_ resource.ResourceWithImportState = &connectorResource{}
+)
+
+func NewConnectorResource() resource.Resource {
+	return &connectorResource{}
+}
+
+type connectorResource struct {
+	client *client.Client
+}
+
+type connectorResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CommandLine types.String `tfsdk:"command_line"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	CommandIDs  types.Set    `tfsdk:"command_ids"`
+}
+
+func (r *connectorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector"
+}
+
+func (r *connectorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon connector, a persistent process such as the Perl or SSH connector running the checks of the linked commands.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Connector ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Connector name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Connector description",
+			},
+			"command_line": schema.StringAttribute{
+				Required:    true,
+				Description: "Command line starting the connector, such as $CONNECTORS$/centreon_connector_perl --log-file=/var/log/centreon-engine/connector-perl.log",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the connector is enabled",
+			},
+			"command_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the commands run by the connector",
+			},
+		},
+	}
+}
+
+func (r *connectorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan.
+func (m connectorResourceModel) request(ctx context.Context) (*client.ConnectorRequest, diag.Diagnostics) {
+	commands, diags := intsFromSet(ctx, m.CommandIDs)
+
+	return &client.ConnectorRequest{
+		Name:        m.Name.ValueString(),
+		Description: optionalString(m.Description),
+		CommandLine: m.CommandLine.ValueString(),
+		IsActivated: m.IsActivated.ValueBool(),
+		Commands:    nonNilInts(commands),
+	}, diags
+}
+
+// refresh updates the model with the connector returned by the API.
+func (m *connectorResourceModel) refresh(connector *client.Connector) {
+	m.ID = types.Int64Value(int64(connector.ID))
+	m.Name = types.StringValue(connector.Name)
+	m.Description = stringFromAPI(connector.Description, m.Description)
+	m.CommandLine = types.StringValue(connector.CommandLine)
+	m.IsActivated = types.BoolValue(connector.IsActivated)
+	m.CommandIDs = optionalSetFromInts(connector.Commands, m.CommandIDs)
+}
+
+func (r *connectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan connectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating connector", map[string]interface{}{
+		"name": connectorReq.Name,
+	})
+
+	connector, err := r.client.CreateConnector(connectorReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating connector",
+			fmt.Sprintf("Could not create connector: %v", err),
+		)
+		return
+	}
+	plan.refresh(connector)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating connector",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.client.GetConnector(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading connector",
+			fmt.Sprintf("Could not read connector %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(connector)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *connectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan connectorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateConnector(id, connectorReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating connector",
+			fmt.Sprintf("Could not update connector %d: %v", id, err),
+		)
+		return
+	}
+
+	connector, err := r.client.GetConnector(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading connector",
+			fmt.Sprintf("Could not read connector %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(connector)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating connector",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *connectorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state connectorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteConnector(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting connector",
+			fmt.Sprintf("Could not delete connector %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting connector",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *connectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &connectorsDataSource{}
+
+func NewConnectorsDataSource() datasource.DataSource {
+	return &connectorsDataSource{}
+}
+
+type connectorsDataSource struct {
+	client *client.Client
+}
+
+type connectorDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CommandLine types.String `tfsdk:"command_line"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	CommandIDs  types.Set    `tfsdk:"command_ids"`
+}
+
+type connectorsDataSourceModel struct {
+	Limit      types.Int64       `tfsdk:"limit"`
+	Page       types.Int64       `tfsdk:"page"`
+	Search     *searchModel      `tfsdk:"search"`
+	Connectors []connectorDetail `tfsdk:"connectors"`
+	Id         types.String      `tfsdk:"id"`
+}
+
+func (d *connectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connectors"
+}
+
+func (d *connectorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of connectors, for instance to look up the ID of a connector by name.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"connectors": schema.ListNestedAttribute{
+				Description: "List of connectors",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Connector ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Connector name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Connector description",
+							Computed:    true,
+						},
+						"command_line": schema.StringAttribute{
+							Description: "Command line starting the connector",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the connector is enabled",
+							Computed:    true,
+						},
+						"command_ids": schema.SetAttribute{
+							Description: "IDs of the commands run by the connector",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *connectorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *connectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state connectorsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorsResponse, err := d.client.GetConnectors(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Connectors",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Connectors = make([]connectorDetail, len(connectorsResponse.Result))
+	for i, connector := range connectorsResponse.Result {
+		state.Connectors[i] = connectorDetail{
+			ID:          types.Int64Value(int64(connector.ID)),
+			Name:        types.StringValue(connector.Name),
+			Description: stringFromAPI(connector.Description, types.StringNull()),
+			CommandLine: types.StringValue(connector.CommandLine),
+			IsActivated: types.BoolValue(connector.IsActivated),
+			CommandIDs:  setFromInts(connector.Commands),
+		}
+	}
+
+	state.Id = types.StringValue("connectors")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
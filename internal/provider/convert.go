@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-centreon/internal/validation"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// collectionValue is implemented by both list and set values.
+type collectionValue interface {
+	attr.Value
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// intsFromCollection converts a list or set of Int64 values to the ints
+// expected by the API. Null and unknown values are converted to nil.
+func intsFromCollection(ctx context.Context, collection collectionValue) ([]int, diag.Diagnostics) {
+	if collection.IsNull() || collection.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []int64
+	diags := collection.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ints := make([]int, len(values))
+	for i, v := range values {
+		ints[i] = int(v)
+	}
+	return ints, diags
+}
+
+// intsFromList converts a list of Int64 values to the ints expected by the API.
+func intsFromList(ctx context.Context, list types.List) ([]int, diag.Diagnostics) {
+	return intsFromCollection(ctx, list)
+}
+
+// intsFromSet converts a set of Int64 values to the ints expected by the API.
+func intsFromSet(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	return intsFromCollection(ctx, set)
+}
+
+// knownInt64s returns the known elements of a set of Int64 values.
+func knownInt64s(set types.Set) []int64 {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var values []int64
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.Int64); ok && !value.IsNull() && !value.IsUnknown() {
+			values = append(values, value.ValueInt64())
+		}
+	}
+	return values
+}
+
+// int64Values converts ints returned by the API to Int64 values.
+func int64Values(ints []int) []attr.Value {
+	elements := make([]attr.Value, len(ints))
+	for i, v := range ints {
+		elements[i] = types.Int64Value(int64(v))
+	}
+	return elements
+}
+
+// stringValues converts strings returned by the API to String values.
+func stringValues(strings []string) []attr.Value {
+	elements := make([]attr.Value, len(strings))
+	for i, v := range strings {
+		elements[i] = types.StringValue(v)
+	}
+	return elements
+}
+
+// listFromInts converts ints returned by the API to a list of Int64 values.
+func listFromInts(ints []int) types.List {
+	return types.ListValueMust(types.Int64Type, int64Values(ints))
+}
+
+// listFromStrings converts strings returned by the API to a list of String values.
+func listFromStrings(strings []string) types.List {
+	return types.ListValueMust(types.StringType, stringValues(strings))
+}
+
+// setFromInts converts ints returned by the API to a set of Int64 values.
+func setFromInts(ints []int) types.Set {
+	return types.SetValueMust(types.Int64Type, int64Values(ints))
+}
+
+// optionalSetFromInts converts ints returned by the API to a set of Int64
+// values. No ints are kept null when the set is unset.
+func optionalSetFromInts(ints []int, current types.Set) types.Set {
+	if len(ints) == 0 && current.IsNull() {
+		return types.SetNull(types.Int64Type)
+	}
+	return setFromInts(ints)
+}
+
+// optionalInt64 converts an Int64 to the nullable int expected by the API.
+func optionalInt64(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := int(value.ValueInt64())
+	return &v
+}
+
+// int64FromAPI converts a nullable int returned by the API.
+func int64FromAPI(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+// timeValue converts a nullable time returned by the monitoring API to an
+// RFC 3339 string.
+func timeValue(value *time.Time) types.String {
+	if value == nil || value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
+
+// setFromStrings converts strings returned by the API to a set of String values.
+func setFromStrings(strings []string) types.Set {
+	return types.SetValueMust(types.StringType, stringValues(strings))
+}
+
+// Centreon stores enabled flags as 0 (disabled), 1 (enabled) or 2 (inherited
+// from the templates).
+const (
+	triStateDisabled = 0
+	triStateEnabled  = 1
+	triStateInherit  = 2
+)
+
+// boolFromTriState converts an enabled flag returned by the API to a Bool,
+// null meaning the value is inherited.
+func boolFromTriState(value int) types.Bool {
+	switch value {
+	case triStateDisabled:
+		return types.BoolValue(false)
+	case triStateEnabled:
+		return types.BoolValue(true)
+	default:
+		return types.BoolNull()
+	}
+}
+
+// triStateFromBool converts a Bool to an enabled flag expected by the API.
+func triStateFromBool(value types.Bool) int {
+	switch {
+	case value.IsNull() || value.IsUnknown():
+		return triStateInherit
+	case value.ValueBool():
+		return triStateEnabled
+	default:
+		return triStateDisabled
+	}
+}
+
+// failureCriteriaCodes converts a set of failure criteria names to the codes
+// expected by the API. A null set is converted to no criteria.
+func failureCriteriaCodes(ctx context.Context, set types.Set, criteria []validation.FailureCriterion) (string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return "", nil
+	}
+
+	var names []string
+	diags := set.ElementsAs(ctx, &names, false)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	codes, err := validation.FailureCriteriaToCodes(names, criteria)
+	if err != nil {
+		diags.AddError("Invalid Failure Criteria", err.Error())
+	}
+	return codes, diags
+}
+
+// failureCriteriaFromCodes converts failure criteria codes returned by the
+// API to a set of names. No criteria is kept null when the set is unset.
+func failureCriteriaFromCodes(codes string, current types.Set, criteria []validation.FailureCriterion) types.Set {
+	names := validation.CodesToFailureCriteria(codes, criteria)
+	if len(names) == 0 && current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return setFromStrings(names)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-centreon/internal/validation"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBoolFromTriState(t *testing.T) {
+	tests := []struct {
+		value int
+		want  types.Bool
+	}{
+		{value: triStateDisabled, want: types.BoolValue(false)},
+		{value: triStateEnabled, want: types.BoolValue(true)},
+		{value: triStateInherit, want: types.BoolNull()},
+		{value: 3, want: types.BoolNull()},
+	}
+
+	for _, tt := range tests {
+		if got := boolFromTriState(tt.value); !got.Equal(tt.want) {
+			t.Errorf("boolFromTriState(%d) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestTriStateFromBool(t *testing.T) {
+	tests := []struct {
+		value types.Bool
+		want  int
+	}{
+		{value: types.BoolValue(false), want: triStateDisabled},
+		{value: types.BoolValue(true), want: triStateEnabled},
+		{value: types.BoolNull(), want: triStateInherit},
+		{value: types.BoolUnknown(), want: triStateInherit},
+	}
+
+	for _, tt := range tests {
+		got := triStateFromBool(tt.value)
+		if got != tt.want {
+			t.Errorf("triStateFromBool(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+		if tt.value.IsUnknown() {
+			continue
+		}
+		if back := boolFromTriState(got); !back.Equal(tt.value) {
+			t.Errorf("boolFromTriState(triStateFromBool(%v)) = %v", tt.value, back)
+		}
+	}
+}
+
+func TestFailureCriteriaCodes(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		set     types.Set
+		want    string
+		wantErr bool
+	}{
+		{name: "null", set: types.SetNull(types.StringType), want: ""},
+		{name: "unknown", set: types.SetUnknown(types.StringType), want: ""},
+		{name: "empty", set: setFromStrings(nil), want: ""},
+		{name: "one state", set: setFromStrings([]string{"critical"}), want: "c"},
+		{name: "unknown state", set: setFromStrings([]string{"broken"}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := failureCriteriaCodes(ctx, tt.set, validation.ServiceFailureCriteria)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("failureCriteriaCodes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailureCriteriaFromCodes(t *testing.T) {
+	tests := []struct {
+		name    string
+		codes   string
+		current types.Set
+		want    types.Set
+	}{
+		{
+			name:    "no codes keep an unset set null",
+			current: types.SetNull(types.StringType),
+			want:    types.SetNull(types.StringType),
+		},
+		{
+			name:    "no codes empty a set",
+			current: setFromStrings([]string{"ok"}),
+			want:    setFromStrings(nil),
+		},
+		{
+			name:    "codes",
+			codes:   "w,c",
+			current: types.SetNull(types.StringType),
+			want:    setFromStrings([]string{"warning", "critical"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := failureCriteriaFromCodes(tt.codes, tt.current, validation.ServiceFailureCriteria)
+			if !got.Equal(tt.want) {
+				t.Errorf("failureCriteriaFromCodes(%q) = %v, want %v", tt.codes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalSetFromInts(t *testing.T) {
+	tests := []struct {
+		name    string
+		ints    []int
+		current types.Set
+		want    types.Set
+	}{
+		{name: "unset", current: types.SetNull(types.Int64Type), want: types.SetNull(types.Int64Type)},
+		{name: "emptied", current: setFromInts([]int{1}), want: setFromInts(nil)},
+		{name: "set", ints: []int{1, 2}, current: types.SetNull(types.Int64Type), want: setFromInts([]int{2, 1})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optionalSetFromInts(tt.ints, tt.current); !got.Equal(tt.want) {
+				t.Errorf("optionalSetFromInts(%v) = %v, want %v", tt.ints, got, tt.want)
+			}
+		})
+	}
+}
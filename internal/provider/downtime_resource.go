@@ -0,0 +1,432 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &downtimeResource{}
+	_ resource.ResourceWithImportState      = &downtimeResource{}
+	_ resource.ResourceWithValidateConfig   = &downtimeResource{}
+	_ resource.ResourceWithConfigValidators = &downtimeResource{}
+)
+
+func NewDowntimeResource() resource.Resource {
+	return &downtimeResource{}
+}
+
+type downtimeResource struct {
+	client *client.Client
+}
+
+type downtimeResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	HostID       types.Int64  `tfsdk:"host_id"`
+	ServiceID    types.Int64  `tfsdk:"service_id"`
+	StartTime    types.String `tfsdk:"start_time"`
+	EndTime      types.String `tfsdk:"end_time"`
+	Fixed        types.Bool   `tfsdk:"fixed"`
+	Duration     types.Int64  `tfsdk:"duration"`
+	Comment      types.String `tfsdk:"comment"`
+	WithServices types.Bool   `tfsdk:"with_services"`
+}
+
+func (r *downtimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_downtime"
+}
+
+func (r *downtimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Schedules a downtime on a host or a service through the real-time monitoring API. " +
+			"The downtime is cancelled on destroy. Downtimes can't be modified, so any change replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Downtime ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the host in downtime, or of the host of the service in downtime",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the service in downtime. The host itself is put in downtime when not set",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the downtime, in RFC 3339 format",
+				Validators: []validator.String{
+					validation.RFC3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the downtime, in RFC 3339 format",
+				Validators: []validator.String{
+					validation.RFC3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fixed": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the downtime lasts from start_time to end_time. A flexible downtime starts with the first problem between them and lasts duration seconds",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Duration of a flexible downtime, in seconds. Required when fixed is false",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Required:    true,
+				Description: "Comment of the downtime",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"with_services": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the services of the host are put in downtime too",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *downtimeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *downtimeResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validation.ConflictingAttributesValidator{
+			Paths: []path.Path{path.Root("service_id"), path.Root("with_services")},
+		},
+	}
+}
+
+// ValidateConfig checks that the downtime ends after it starts, and that
+// flexible downtimes have a duration.
+func (r *downtimeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config downtimeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.StartTime.IsNull() && !config.StartTime.IsUnknown() && !config.EndTime.IsNull() && !config.EndTime.IsUnknown() {
+		start, startErr := time.Parse(time.RFC3339, config.StartTime.ValueString())
+		end, endErr := time.Parse(time.RFC3339, config.EndTime.ValueString())
+		if startErr == nil && endErr == nil && !end.After(start) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("end_time"),
+				"Invalid Downtime",
+				fmt.Sprintf("end_time (%s) must be after start_time (%s).", config.EndTime.ValueString(), config.StartTime.ValueString()),
+			)
+		}
+	}
+
+	if !config.Fixed.IsNull() && !config.Fixed.IsUnknown() && !config.Fixed.ValueBool() && config.Duration.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration"),
+			"Invalid Downtime",
+			"duration is required when fixed is false.",
+		)
+	}
+}
+
+// serviceID returns the ID of the service in downtime, or nil for a host.
+func (m downtimeResourceModel) serviceID() *int {
+	return optionalInt64(m.ServiceID)
+}
+
+// request builds the API payload from the plan. Fixed downtimes last for the
+// whole period.
+func (m downtimeResourceModel) request() (*client.DowntimeRequest, error) {
+	start, err := time.Parse(time.RFC3339, m.StartTime.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, m.EndTime.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_time: %v", err)
+	}
+
+	duration := int(end.Sub(start).Seconds())
+	if !m.Fixed.ValueBool() && !m.Duration.IsNull() {
+		duration = int(m.Duration.ValueInt64())
+	}
+
+	return &client.DowntimeRequest{
+		Comment:      m.Comment.ValueString(),
+		StartTime:    start,
+		EndTime:      end,
+		IsFixed:      m.Fixed.ValueBool(),
+		Duration:     duration,
+		WithServices: m.WithServices.ValueBool(),
+	}, nil
+}
+
+// timeFromAPI converts a time returned by the API, keeping the current value
+// when it denotes the same instant in another format or time zone.
+func timeFromAPI(value time.Time, current types.String) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		if t, err := time.Parse(time.RFC3339, current.ValueString()); err == nil && t.Equal(value) {
+			return current
+		}
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
+
+// refresh updates the model with the downtime returned by the API.
+func (m *downtimeResourceModel) refresh(downtime *client.Downtime) {
+	m.ID = types.Int64Value(int64(downtime.ID))
+	m.HostID = types.Int64Value(int64(downtime.HostID))
+	m.ServiceID = types.Int64Null()
+	if downtime.ServiceID != nil && *downtime.ServiceID != 0 {
+		m.ServiceID = types.Int64Value(int64(*downtime.ServiceID))
+	}
+	m.StartTime = timeFromAPI(downtime.StartTime, m.StartTime)
+	m.EndTime = timeFromAPI(downtime.EndTime, m.EndTime)
+	m.Fixed = types.BoolValue(downtime.IsFixed)
+	if !downtime.IsFixed {
+		m.Duration = types.Int64Value(int64(downtime.Duration))
+	}
+	m.Comment = types.StringValue(downtime.Comment)
+	if m.WithServices.IsNull() {
+		m.WithServices = types.BoolValue(false)
+	}
+}
+
+func (r *downtimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan downtimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtimeReq, err := plan.request()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating downtime",
+			err.Error(),
+		)
+		return
+	}
+
+	hostID := int(plan.HostID.ValueInt64())
+	logging.Info(ctx, "Creating downtime", map[string]interface{}{
+		"host_id": hostID,
+		"comment": downtimeReq.Comment,
+	})
+
+	resources := []client.MonitoringResource{client.NewMonitoringResource(hostID, plan.serviceID())}
+	if err := r.client.AddDowntime(downtimeReq, resources); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating downtime",
+			fmt.Sprintf("Could not create downtime: %v", err),
+		)
+		return
+	}
+
+	var downtime *client.Downtime
+	err = waitFor(ctx, monitoringTimeout, monitoringPollInterval, func() (bool, error) {
+		var err error
+		downtime, err = r.client.FindDowntime(hostID, plan.serviceID(), downtimeReq)
+		return err == nil, err
+	})
+	if err != nil {
+		// Save the downtime without its ID so that it is tainted rather than
+		// orphaned, it is looked up again when read or deleted
+		resp.Diagnostics.AddError(
+			"Error after creating downtime",
+			fmt.Sprintf("The downtime was scheduled but isn't listed by the monitoring: %v", err),
+		)
+		plan.ID = types.Int64Null()
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+	plan.refresh(downtime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// getDowntime returns the downtime of the state. Downtimes saved without their
+// ID, when the monitoring didn't list them in time, are looked up by their
+// attributes.
+func (r *downtimeResource) getDowntime(state downtimeResourceModel) (*client.Downtime, error) {
+	if !state.ID.IsNull() {
+		return r.client.GetDowntime(int(state.ID.ValueInt64()))
+	}
+
+	downtimeReq, err := state.request()
+	if err != nil {
+		return nil, err
+	}
+	return r.client.FindDowntime(int(state.HostID.ValueInt64()), state.serviceID(), downtimeReq)
+}
+
+// Read removes cancelled downtimes from the state. Downtimes that ended are
+// kept, so that Terraform doesn't schedule them again.
+func (r *downtimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state downtimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtime, err := r.getDowntime(state)
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading downtime",
+			fmt.Sprintf("Could not read downtime %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	if downtime.IsCancelled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.refresh(downtime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update only stores the plan: every attribute of a downtime forces its
+// replacement.
+func (r *downtimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan downtimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete cancels the downtime, and the downtimes scheduled with it on the
+// services of the host when with_services is set.
+func (r *downtimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state downtimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		downtime, err := r.getDowntime(state)
+		if client.IsNotFound(err) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting downtime",
+				fmt.Sprintf("Could not look the downtime up: %v", err),
+			)
+			return
+		}
+		state.ID = types.Int64Value(int64(downtime.ID))
+	}
+
+	ids := []int{int(state.ID.ValueInt64())}
+	if state.WithServices.ValueBool() {
+		downtimeReq, err := state.request()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting downtime",
+				err.Error(),
+			)
+			return
+		}
+
+		downtimes, err := r.client.GetDowntimes(int(state.HostID.ValueInt64()), nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting downtime",
+				fmt.Sprintf("Could not list the service downtimes of host %d: %v", state.HostID.ValueInt64(), err),
+			)
+			return
+		}
+		for _, downtime := range downtimes {
+			if downtime.ServiceID != nil && *downtime.ServiceID != 0 && !downtime.IsCancelled &&
+				downtime.Comment == downtimeReq.Comment &&
+				downtime.StartTime.Equal(downtimeReq.StartTime) && downtime.EndTime.Equal(downtimeReq.EndTime) {
+				ids = append(ids, downtime.ID)
+			}
+		}
+	}
+
+	for _, id := range ids {
+		if err := r.client.CancelDowntime(id); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting downtime",
+				fmt.Sprintf("Could not cancel downtime %d: %v", id, err),
+			)
+			return
+		}
+	}
+}
+
+func (r *downtimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &escalationResource{}
+	_ resource.ResourceWithImportState    = &escalationResource{}
+	_ resource.ResourceWithValidateConfig = &escalationResource{}
+)
+
+func NewEscalationResource() resource.Resource {
+	return &escalationResource{}
+}
+
+type escalationResource struct {
+	client *client.Client
+}
+
+type escalationResourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Alias                    types.String `tfsdk:"alias"`
+	FirstNotification        types.Int64  `tfsdk:"first_notification"`
+	LastNotification         types.Int64  `tfsdk:"last_notification"`
+	NotificationInterval     types.Int64  `tfsdk:"notification_interval"`
+	EscalationPeriodID       types.Int64  `tfsdk:"escalation_period_id"`
+	HostEscalationOptions    types.Set    `tfsdk:"host_escalation_options"`
+	ServiceEscalationOptions types.Set    `tfsdk:"service_escalation_options"`
+	ContactGroupIDs          types.Set    `tfsdk:"contact_group_ids"`
+	HostIDs                  types.Set    `tfsdk:"host_ids"`
+	HostGroupIDs             types.Set    `tfsdk:"host_group_ids"`
+	Services                 types.Set    `tfsdk:"services"`
+	ServiceGroupIDs          types.Set    `tfsdk:"service_group_ids"`
+	Comment                  types.String `tfsdk:"comment"`
+}
+
+func (r *escalationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalation"
+}
+
+func (r *escalationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon escalation. Once the linked hosts or services have sent first_notification notifications, the contact groups of the escalation are notified as well.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Escalation ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Escalation name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Description: "Escalation alias",
+			},
+			"first_notification": schema.Int64Attribute{
+				Required:    true,
+				Description: "Number of the first notification sent to the escalation contact groups",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
+			},
+			"last_notification": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Number of the last notification sent to the escalation contact groups. 0 keeps notifying them until recovery",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
+			},
+			"notification_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Interval between escalated notifications",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
+			},
+			"escalation_period_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the timeperiod during which the escalation applies",
+			},
+			"host_escalation_options": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Host states that are escalated. One or more of down, unreachable and recovery",
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.HostEscalationOptions},
+				},
+			},
+			"service_escalation_options": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Service states that are escalated. One or more of warning, unknown, critical and recovery",
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.ServiceEscalationOptions},
+				},
+			},
+			"contact_group_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the contact groups notified by the escalation",
+			},
+			"host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the escalated hosts",
+			},
+			"host_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the escalated host groups",
+			},
+			"services": schema.SetNestedAttribute{
+				Optional:     true,
+				Description:  "Escalated services",
+				NestedObject: serviceReferenceSchema(),
+			},
+			"service_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the escalated service groups",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *escalationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the escalated notifications form a valid range.
+func (r *escalationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var first, last types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("first_notification"), &first)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("last_notification"), &last)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if first.IsNull() || first.IsUnknown() || last.IsNull() || last.IsUnknown() || last.ValueInt64() == 0 {
+		return
+	}
+	if last.ValueInt64() < first.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("last_notification"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("last_notification (%d) must be 0 or greater than or equal to first_notification (%d).",
+				last.ValueInt64(), first.ValueInt64()),
+		)
+	}
+}
+
+// request builds the API payload from the plan.
+func (m escalationResourceModel) request(ctx context.Context) (*client.EscalationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hostOptions, d := failureCriteriaCodes(ctx, m.HostEscalationOptions, validation.HostEscalationOptions)
+	diags.Append(d...)
+	serviceOptions, d := failureCriteriaCodes(ctx, m.ServiceEscalationOptions, validation.ServiceEscalationOptions)
+	diags.Append(d...)
+	contactGroups, d := intsFromSet(ctx, m.ContactGroupIDs)
+	diags.Append(d...)
+	hosts, d := intsFromSet(ctx, m.HostIDs)
+	diags.Append(d...)
+	hostGroups, d := intsFromSet(ctx, m.HostGroupIDs)
+	diags.Append(d...)
+	services, d := serviceReferencesFromSet(ctx, m.Services)
+	diags.Append(d...)
+	serviceGroups, d := intsFromSet(ctx, m.ServiceGroupIDs)
+	diags.Append(d...)
+
+	return &client.EscalationRequest{
+		Name:                     m.Name.ValueString(),
+		Alias:                    optionalString(m.Alias),
+		FirstNotification:        int(m.FirstNotification.ValueInt64()),
+		LastNotification:         int(m.LastNotification.ValueInt64()),
+		NotificationInterval:     optionalInt64(m.NotificationInterval),
+		EscalationPeriodID:       optionalInt64(m.EscalationPeriodID),
+		HostEscalationOptions:    hostOptions,
+		ServiceEscalationOptions: serviceOptions,
+		ContactGroups:            nonNilInts(contactGroups),
+		Hosts:                    nonNilInts(hosts),
+		HostGroups:               nonNilInts(hostGroups),
+		Services:                 services,
+		ServiceGroups:            nonNilInts(serviceGroups),
+		Comment:                  optionalString(m.Comment),
+	}, diags
+}
+
+// refresh updates the model with the escalation returned by the API.
+func (m *escalationResourceModel) refresh(escalation *client.Escalation) {
+	m.ID = types.Int64Value(int64(escalation.ID))
+	m.Name = types.StringValue(escalation.Name)
+	m.Alias = stringFromAPI(escalation.Alias, m.Alias)
+	m.FirstNotification = types.Int64Value(int64(escalation.FirstNotification))
+	m.LastNotification = types.Int64Value(int64(escalation.LastNotification))
+	m.NotificationInterval = int64FromAPI(escalation.NotificationInterval)
+	m.EscalationPeriodID = int64FromAPI(escalation.EscalationPeriodID)
+	m.HostEscalationOptions = failureCriteriaFromCodes(escalation.HostEscalationOptions, m.HostEscalationOptions, validation.HostEscalationOptions)
+	m.ServiceEscalationOptions = failureCriteriaFromCodes(escalation.ServiceEscalationOptions, m.ServiceEscalationOptions, validation.ServiceEscalationOptions)
+	m.ContactGroupIDs = setFromInts(escalation.ContactGroups)
+	m.HostIDs = optionalSetFromInts(escalation.Hosts, m.HostIDs)
+	m.HostGroupIDs = optionalSetFromInts(escalation.HostGroups, m.HostGroupIDs)
+	m.Services = optionalSetFromServiceReferences(escalation.Services, m.Services)
+	m.ServiceGroupIDs = optionalSetFromInts(escalation.ServiceGroups, m.ServiceGroupIDs)
+	m.Comment = stringFromAPI(escalation.Comment, m.Comment)
+}
+
+func (r *escalationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan escalationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	escalationReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating escalation", map[string]interface{}{
+		"name": escalationReq.Name,
+	})
+
+	escalation, err := r.client.CreateEscalation(escalationReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating escalation",
+			fmt.Sprintf("Could not create escalation: %v", err),
+		)
+		return
+	}
+	plan.refresh(escalation)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating escalation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *escalationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state escalationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	escalation, err := r.client.GetEscalation(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading escalation",
+			fmt.Sprintf("Could not read escalation %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(escalation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *escalationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan escalationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	escalationReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateEscalation(id, escalationReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating escalation",
+			fmt.Sprintf("Could not update escalation %d: %v", id, err),
+		)
+		return
+	}
+
+	escalation, err := r.client.GetEscalation(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading escalation",
+			fmt.Sprintf("Could not read escalation %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(escalation)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating escalation",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *escalationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state escalationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteEscalation(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting escalation",
+			fmt.Sprintf("Could not delete escalation %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting escalation",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *escalationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
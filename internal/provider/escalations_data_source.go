@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &escalationsDataSource{}
+
+func NewEscalationsDataSource() datasource.DataSource {
+	return &escalationsDataSource{}
+}
+
+type escalationsDataSource struct {
+	client *client.Client
+}
+
+type escalationDetail struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Alias                    types.String `tfsdk:"alias"`
+	FirstNotification        types.Int64  `tfsdk:"first_notification"`
+	LastNotification         types.Int64  `tfsdk:"last_notification"`
+	NotificationInterval     types.Int64  `tfsdk:"notification_interval"`
+	EscalationPeriodID       types.Int64  `tfsdk:"escalation_period_id"`
+	HostEscalationOptions    types.Set    `tfsdk:"host_escalation_options"`
+	ServiceEscalationOptions types.Set    `tfsdk:"service_escalation_options"`
+	ContactGroupIDs          types.Set    `tfsdk:"contact_group_ids"`
+	HostIDs                  types.Set    `tfsdk:"host_ids"`
+	HostGroupIDs             types.Set    `tfsdk:"host_group_ids"`
+	Services                 types.Set    `tfsdk:"services"`
+	ServiceGroupIDs          types.Set    `tfsdk:"service_group_ids"`
+	Comment                  types.String `tfsdk:"comment"`
+}
+
+type escalationsDataSourceModel struct {
+	Limit       types.Int64        `tfsdk:"limit"`
+	Page        types.Int64        `tfsdk:"page"`
+	Search      *searchModel       `tfsdk:"search"`
+	Escalations []escalationDetail `tfsdk:"escalations"`
+	Id          types.String       `tfsdk:"id"`
+}
+
+func (d *escalationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_escalations"
+}
+
+func (d *escalationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of escalations.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"escalations": schema.ListNestedAttribute{
+				Description: "List of escalations",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Escalation ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Escalation name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Escalation alias",
+							Computed:    true,
+						},
+						"first_notification": schema.Int64Attribute{
+							Description: "Number of the first escalated notification",
+							Computed:    true,
+						},
+						"last_notification": schema.Int64Attribute{
+							Description: "Number of the last escalated notification, 0 meaning until recovery",
+							Computed:    true,
+						},
+						"notification_interval": schema.Int64Attribute{
+							Description: "Interval between escalated notifications",
+							Computed:    true,
+						},
+						"escalation_period_id": schema.Int64Attribute{
+							Description: "Escalation timeperiod ID",
+							Computed:    true,
+						},
+						"host_escalation_options": schema.SetAttribute{
+							Description: "Escalated host states",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"service_escalation_options": schema.SetAttribute{
+							Description: "Escalated service states",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"contact_group_ids": schema.SetAttribute{
+							Description: "Notified contact group IDs",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"host_ids": schema.SetAttribute{
+							Description: "Escalated host IDs",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"host_group_ids": schema.SetAttribute{
+							Description: "Escalated host group IDs",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"services": schema.SetAttribute{
+							Description: "Escalated services, identified by host_id and service_id",
+							Computed:    true,
+							ElementType: serviceReferenceType,
+						},
+						"service_group_ids": schema.SetAttribute{
+							Description: "Escalated service group IDs",
+							Computed:    true,
+							ElementType: types.Int64Type,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Comment",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *escalationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *escalationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state escalationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	escalationsResponse, err := d.client.GetEscalations(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Escalations",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Escalations = make([]escalationDetail, len(escalationsResponse.Result))
+	for i, escalation := range escalationsResponse.Result {
+		state.Escalations[i] = escalationDetail{
+			ID:                       types.Int64Value(int64(escalation.ID)),
+			Name:                     types.StringValue(escalation.Name),
+			Alias:                    stringFromAPI(escalation.Alias, types.StringNull()),
+			FirstNotification:        types.Int64Value(int64(escalation.FirstNotification)),
+			LastNotification:         types.Int64Value(int64(escalation.LastNotification)),
+			NotificationInterval:     int64FromAPI(escalation.NotificationInterval),
+			EscalationPeriodID:       int64FromAPI(escalation.EscalationPeriodID),
+			HostEscalationOptions:    setFromStrings(validation.CodesToFailureCriteria(escalation.HostEscalationOptions, validation.HostEscalationOptions)),
+			ServiceEscalationOptions: setFromStrings(validation.CodesToFailureCriteria(escalation.ServiceEscalationOptions, validation.ServiceEscalationOptions)),
+			ContactGroupIDs:          setFromInts(escalation.ContactGroups),
+			HostIDs:                  setFromInts(escalation.Hosts),
+			HostGroupIDs:             setFromInts(escalation.HostGroups),
+			Services:                 setFromServiceReferences(escalation.Services),
+			ServiceGroupIDs:          setFromInts(escalation.ServiceGroups),
+			Comment:                  stringFromAPI(escalation.Comment, types.StringNull()),
+		}
+	}
+
+	state.Id = types.StringValue("escalations")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
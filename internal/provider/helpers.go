@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-centreon/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// handleConfigurationReload generates and reloads the configuration of the
+// monitoring servers when the provider is configured to do so.
+func handleConfigurationReload(c *client.Client) error {
+	if c.GenerateAndReloadConfiguration {
+		if err := c.ReloadConfiguration(); err != nil {
+			return fmt.Errorf("failed to generate and reload configuration: %v", err)
+		}
+	}
+	return nil
+}
+
+// importStateID imports a resource identified by its numeric Centreon ID.
+func importStateID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a numeric ID, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(id))...)
+}
+
+// searchQuery builds the search parameter of a list data source.
+func searchQuery(search *searchModel) string {
+	if search == nil || search.Name.IsNull() || search.Value.IsNull() {
+		return "{}"
+	}
+	return fmt.Sprintf("{\"%s\":\"%s\"}", search.Name.ValueString(), search.Value.ValueString())
+}
+
+// optionalString converts a String to the nullable string expected by the API.
+func optionalString(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := value.ValueString()
+	return &v
+}
+
+// stringFromAPI converts a nullable string returned by the API. Empty values
+// are kept null unless the attribute is already set, to avoid spurious diffs.
+func stringFromAPI(value *string, current types.String) types.String {
+	if value == nil || (*value == "" && current.IsNull()) {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// Real-time commands such as downtimes and acknowledgements are processed
+// asynchronously by the monitoring engine: their result is polled for at
+// monitoringPollInterval until monitoringTimeout.
+const (
+	monitoringTimeout      = 30 * time.Second
+	monitoringPollInterval = 2 * time.Second
+)
+
+// waitFor calls check every interval until it reports done, returning the
+// last error of check if timeout elapses first.
+func waitFor(ctx context.Context, timeout, interval time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if done {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			if err == nil {
+				err = fmt.Errorf("timed out after %s", timeout)
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &hostCategoriesDataSource{}
+
+func NewHostCategoriesDataSource() datasource.DataSource {
+	return &hostCategoriesDataSource{}
+}
+
+type hostCategoriesDataSource struct {
+	client *client.Client
+}
+
+type hostCategoryDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+type hostCategoriesDataSourceModel struct {
+	Limit      types.Int64          `tfsdk:"limit"`
+	Page       types.Int64          `tfsdk:"page"`
+	Search     *searchModel         `tfsdk:"search"`
+	Categories []hostCategoryDetail `tfsdk:"categories"`
+	Id         types.String         `tfsdk:"id"`
+}
+
+func (d *hostCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_categories"
+}
+
+func (d *hostCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of host categories.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "List of host categories",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Category ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Category name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Category alias",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the category is activated",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Comment",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *hostCategoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *hostCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hostCategoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoriesResponse, err := d.client.GetHostCategories(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Host Categories",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Categories = make([]hostCategoryDetail, len(categoriesResponse.Result))
+	for i, category := range categoriesResponse.Result {
+		state.Categories[i] = hostCategoryDetail{
+			ID:          types.Int64Value(int64(category.ID)),
+			Name:        types.StringValue(category.Name),
+			Alias:       types.StringValue(category.Alias),
+			IsActivated: types.BoolValue(category.IsActivated),
+			Comment:     stringFromAPI(category.Comment, types.StringNull()),
+		}
+	}
+
+	state.Id = types.StringValue("host_categories")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
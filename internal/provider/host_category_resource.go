@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &hostCategoryResource{}
+	_ resource.ResourceWithImportState = &hostCategoryResource{}
+)
+
+func NewHostCategoryResource() resource.Resource {
+	return &hostCategoryResource{}
+}
+
+type hostCategoryResource struct {
+	client *client.Client
+}
+
+type hostCategoryResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+func (r *hostCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_category"
+}
+
+func (r *hostCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon host category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Host category ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Host category name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Host category alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the host category is activated",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *hostCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m hostCategoryResourceModel) request() *client.HostCategoryRequest {
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	return &client.HostCategoryRequest{
+		Name:        m.Name.ValueString(),
+		Alias:       alias,
+		IsActivated: m.IsActivated.ValueBool(),
+		Comment:     optionalString(m.Comment),
+	}
+}
+
+// refresh updates the model with the host category returned by the API.
+func (m *hostCategoryResourceModel) refresh(category *client.HostCategory) {
+	m.ID = types.Int64Value(int64(category.ID))
+	m.Name = types.StringValue(category.Name)
+	m.Alias = types.StringValue(category.Alias)
+	m.IsActivated = types.BoolValue(category.IsActivated)
+	m.Comment = stringFromAPI(category.Comment, m.Comment)
+}
+
+func (r *hostCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hostCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating host category", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	category, err := r.client.CreateHostCategory(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating host category",
+			fmt.Sprintf("Could not create host category: %v", err),
+		)
+		return
+	}
+	plan.refresh(category)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating host category",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hostCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetHostCategory(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host category",
+			fmt.Sprintf("Could not read host category %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(category)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *hostCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hostCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateHostCategory(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating host category",
+			fmt.Sprintf("Could not update host category %d: %v", id, err),
+		)
+		return
+	}
+
+	category, err := r.client.GetHostCategory(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host category",
+			fmt.Sprintf("Could not read host category %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(category)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating host category",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hostCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteHostCategory(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting host category",
+			fmt.Sprintf("Could not delete host category %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting host category",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *hostCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &hostDependencyResource{}
+	_ resource.ResourceWithImportState    = &hostDependencyResource{}
+	_ resource.ResourceWithValidateConfig = &hostDependencyResource{}
+)
+
+func NewHostDependencyResource() resource.Resource {
+	return &hostDependencyResource{}
+}
+
+type hostDependencyResource struct {
+	client *client.Client
+}
+
+type hostDependencyResourceModel struct {
+	ID                          types.Int64  `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	ParentHostIDs               types.Set    `tfsdk:"parent_host_ids"`
+	ChildHostIDs                types.Set    `tfsdk:"child_host_ids"`
+	InheritsParent              types.Bool   `tfsdk:"inherits_parent"`
+	ExecutionFailureCriteria    types.Set    `tfsdk:"execution_failure_criteria"`
+	NotificationFailureCriteria types.Set    `tfsdk:"notification_failure_criteria"`
+	Comment                     types.String `tfsdk:"comment"`
+}
+
+func (r *hostDependencyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_dependency"
+}
+
+func (r *hostDependencyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	criteria := "One or more of up, down, unreachable and pending, or none"
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon host dependency. Checks or notifications of the child hosts are suppressed while a parent host matches the failure criteria.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Host dependency ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Host dependency name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Host dependency description",
+			},
+			"parent_host_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the hosts the child hosts depend on",
+			},
+			"child_host_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the dependent hosts",
+			},
+			"inherits_parent": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the dependency also applies when the parent hosts themselves depend on failing hosts",
+			},
+			"execution_failure_criteria": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Parent host states that suppress checks of the child hosts. " + criteria,
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.HostFailureCriteria},
+				},
+			},
+			"notification_failure_criteria": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Parent host states that suppress notifications of the child hosts. " + criteria,
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.HostFailureCriteria},
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *hostDependencyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects hosts that would depend on themselves.
+func (r *hostDependencyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var parents, children types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parent_host_ids"), &parents)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("child_host_ids"), &children)...)
+	if resp.Diagnostics.HasError() || parents.IsUnknown() || children.IsUnknown() {
+		return
+	}
+
+	for _, child := range children.Elements() {
+		for _, parent := range parents.Elements() {
+			if !child.IsUnknown() && child.Equal(parent) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("child_host_ids").AtSetValue(child),
+					"Invalid Host Dependency",
+					fmt.Sprintf("Host %s can't be both a parent and a child of the dependency.", child),
+				)
+			}
+		}
+	}
+}
+
+// request builds the API payload from the plan.
+func (m hostDependencyResourceModel) request(ctx context.Context) (*client.HostDependencyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parents, d := intsFromSet(ctx, m.ParentHostIDs)
+	diags.Append(d...)
+	children, d := intsFromSet(ctx, m.ChildHostIDs)
+	diags.Append(d...)
+	execution, d := failureCriteriaCodes(ctx, m.ExecutionFailureCriteria, validation.HostFailureCriteria)
+	diags.Append(d...)
+	notification, d := failureCriteriaCodes(ctx, m.NotificationFailureCriteria, validation.HostFailureCriteria)
+	diags.Append(d...)
+
+	return &client.HostDependencyRequest{
+		Name:                        m.Name.ValueString(),
+		Description:                 optionalString(m.Description),
+		Parents:                     nonNilInts(parents),
+		Children:                    nonNilInts(children),
+		InheritsParent:              m.InheritsParent.ValueBool(),
+		ExecutionFailureCriteria:    execution,
+		NotificationFailureCriteria: notification,
+		Comment:                     optionalString(m.Comment),
+	}, diags
+}
+
+// refresh updates the model with the host dependency returned by the API.
+func (m *hostDependencyResourceModel) refresh(dependency *client.HostDependency) {
+	m.ID = types.Int64Value(int64(dependency.ID))
+	m.Name = types.StringValue(dependency.Name)
+	m.Description = stringFromAPI(dependency.Description, m.Description)
+	m.ParentHostIDs = setFromInts(dependency.Parents)
+	m.ChildHostIDs = setFromInts(dependency.Children)
+	m.InheritsParent = types.BoolValue(dependency.InheritsParent)
+	m.ExecutionFailureCriteria = failureCriteriaFromCodes(dependency.ExecutionFailureCriteria, m.ExecutionFailureCriteria, validation.HostFailureCriteria)
+	m.NotificationFailureCriteria = failureCriteriaFromCodes(dependency.NotificationFailureCriteria, m.NotificationFailureCriteria, validation.HostFailureCriteria)
+	m.Comment = stringFromAPI(dependency.Comment, m.Comment)
+}
+
+func (r *hostDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hostDependencyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependencyReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating host dependency", map[string]interface{}{
+		"name": dependencyReq.Name,
+	})
+
+	dependency, err := r.client.CreateHostDependency(dependencyReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating host dependency",
+			fmt.Sprintf("Could not create host dependency: %v", err),
+		)
+		return
+	}
+	plan.refresh(dependency)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating host dependency",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hostDependencyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependency, err := r.client.GetHostDependency(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host dependency",
+			fmt.Sprintf("Could not read host dependency %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(dependency)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *hostDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hostDependencyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependencyReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateHostDependency(id, dependencyReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating host dependency",
+			fmt.Sprintf("Could not update host dependency %d: %v", id, err),
+		)
+		return
+	}
+
+	dependency, err := r.client.GetHostDependency(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host dependency",
+			fmt.Sprintf("Could not read host dependency %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(dependency)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating host dependency",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hostDependencyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteHostDependency(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting host dependency",
+			fmt.Sprintf("Could not delete host dependency %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting host dependency",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *hostDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
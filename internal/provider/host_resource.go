@@ -3,20 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"terraform-provider-centreon/internal/client"
 	"terraform-provider-centreon/internal/logging"
 	"terraform-provider-centreon/internal/validation"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = &hostResource{}
+var (
+	_ resource.Resource                     = &hostResource{}
+	_ resource.ResourceWithValidateConfig   = &hostResource{}
+	_ resource.ResourceWithConfigValidators = &hostResource{}
+	_ resource.ResourceWithModifyPlan       = &hostResource{}
+	_ resource.ResourceWithUpgradeState     = &hostResource{}
+)
 
 func NewHostResource() resource.Resource {
 	return &hostResource{}
@@ -27,56 +43,72 @@ type hostResource struct {
 }
 
 type hostResourceModel struct {
-	MonitoringServerID        types.Int64    `tfsdk:"monitoring_server_id"`
-	Name                      types.String   `tfsdk:"name"`
-	Address                   types.String   `tfsdk:"address"`
-	Alias                     types.String   `tfsdk:"alias"`
-	SNMPCommunity             types.String   `tfsdk:"snmp_community"`
-	SNMPVersion               types.String   `tfsdk:"snmp_version"`
-	TimezoneID                types.Int64    `tfsdk:"timezone_id"`
-	SeverityID                types.Int64    `tfsdk:"severity_id"`
-	CheckCommandID            types.Int64    `tfsdk:"check_command_id"`
-	CheckCommandArgs          []types.String `tfsdk:"check_command_args"`
-	CheckTimeperiodID         types.Int64    `tfsdk:"check_timeperiod_id"`
-	MaxCheckAttempts          types.Int64    `tfsdk:"max_check_attempts"`
-	NormalCheckInterval       types.Int64    `tfsdk:"normal_check_interval"`
-	RetryCheckInterval        types.Int64    `tfsdk:"retry_check_interval"`
-	ActiveCheckEnabled        types.Int64    `tfsdk:"active_check_enabled"`
-	PassiveCheckEnabled       types.Int64    `tfsdk:"passive_check_enabled"`
-	NotificationEnabled       types.Int64    `tfsdk:"notification_enabled"`
-	NotificationOptions       types.Int64    `tfsdk:"notification_options"`
-	NotificationInterval      types.Int64    `tfsdk:"notification_interval"`
-	NotificationTimeperiodID  types.Int64    `tfsdk:"notification_timeperiod_id"`
-	FirstNotificationDelay    types.Int64    `tfsdk:"first_notification_delay"`
-	RecoveryNotificationDelay types.Int64    `tfsdk:"recovery_notification_delay"`
-	AcknowledgementTimeout    types.Int64    `tfsdk:"acknowledgement_timeout"`
-	FreshnessChecked          types.Int64    `tfsdk:"freshness_checked"`
-	FreshnessThreshold        types.Int64    `tfsdk:"freshness_threshold"`
-	FlapDetectionEnabled      types.Int64    `tfsdk:"flap_detection_enabled"`
-	LowFlapThreshold          types.Int64    `tfsdk:"low_flap_threshold"`
-	HighFlapThreshold         types.Int64    `tfsdk:"high_flap_threshold"`
-	EventHandlerEnabled       types.Int64    `tfsdk:"event_handler_enabled"`
-	EventHandlerCommandID     types.Int64    `tfsdk:"event_handler_command_id"`
-	EventHandlerCommandArgs   []types.String `tfsdk:"event_handler_command_args"`
-	NoteURL                   types.String   `tfsdk:"note_url"`
-	Note                      types.String   `tfsdk:"note"`
-	ActionURL                 types.String   `tfsdk:"action_url"`
-	IconID                    types.Int64    `tfsdk:"icon_id"`
-	IconAlternative           types.String   `tfsdk:"icon_alternative"`
-	Comment                   types.String   `tfsdk:"comment"`
-	IsActivated               types.Bool     `tfsdk:"is_activated"`
-	Categories                []types.Int64  `tfsdk:"categories"`
-	Groups                    []types.Int64  `tfsdk:"groups"`
-	Templates                 []types.Int64  `tfsdk:"templates"`
-	Macros                    []macroModel   `tfsdk:"macros"`
-	GeoCoords                 types.String   `tfsdk:"geo_coords"`
+	ID                        types.Int64           `tfsdk:"id"`
+	MonitoringServerID        types.Int64           `tfsdk:"monitoring_server_id"`
+	MonitoringServerName      types.String          `tfsdk:"monitoring_server_name"`
+	Name                      types.String          `tfsdk:"name"`
+	Address                   types.String          `tfsdk:"address"`
+	Alias                     types.String          `tfsdk:"alias"`
+	SNMPCommunity             types.String          `tfsdk:"snmp_community"`
+	SNMPVersion               types.String          `tfsdk:"snmp_version"`
+	TimezoneID                types.Int64           `tfsdk:"timezone_id"`
+	SeverityID                types.Int64           `tfsdk:"severity_id"`
+	CheckCommandID            types.Int64           `tfsdk:"check_command_id"`
+	CheckCommandArgs          []types.String        `tfsdk:"check_command_args"`
+	CheckTimeperiodID         types.Int64           `tfsdk:"check_timeperiod_id"`
+	MaxCheckAttempts          types.Int64           `tfsdk:"max_check_attempts"`
+	NormalCheckInterval       types.Int64           `tfsdk:"normal_check_interval"`
+	RetryCheckInterval        types.Int64           `tfsdk:"retry_check_interval"`
+	ActiveCheckEnabled        types.Bool            `tfsdk:"active_check_enabled"`
+	PassiveCheckEnabled       types.Bool            `tfsdk:"passive_check_enabled"`
+	NotificationEnabled       types.Bool            `tfsdk:"notification_enabled"`
+	NotificationOptions       types.Int64           `tfsdk:"notification_options"`
+	NotificationTypes         types.Set             `tfsdk:"notification_types"`
+	NotificationInterval      types.Int64           `tfsdk:"notification_interval"`
+	NotificationTimeperiodID  types.Int64           `tfsdk:"notification_timeperiod_id"`
+	FirstNotificationDelay    types.Int64           `tfsdk:"first_notification_delay"`
+	RecoveryNotificationDelay types.Int64           `tfsdk:"recovery_notification_delay"`
+	AcknowledgementTimeout    types.Int64           `tfsdk:"acknowledgement_timeout"`
+	FreshnessChecked          types.Bool            `tfsdk:"freshness_checked"`
+	FreshnessThreshold        types.Int64           `tfsdk:"freshness_threshold"`
+	FlapDetectionEnabled      types.Bool            `tfsdk:"flap_detection_enabled"`
+	LowFlapThreshold          types.Int64           `tfsdk:"low_flap_threshold"`
+	HighFlapThreshold         types.Int64           `tfsdk:"high_flap_threshold"`
+	EventHandlerEnabled       types.Bool            `tfsdk:"event_handler_enabled"`
+	EventHandlerCommandID     types.Int64           `tfsdk:"event_handler_command_id"`
+	EventHandlerCommandArgs   []types.String        `tfsdk:"event_handler_command_args"`
+	NoteURL                   types.String          `tfsdk:"note_url"`
+	Note                      types.String          `tfsdk:"note"`
+	ActionURL                 types.String          `tfsdk:"action_url"`
+	IconID                    types.Int64           `tfsdk:"icon_id"`
+	IconAlternative           types.String          `tfsdk:"icon_alternative"`
+	Comment                   types.String          `tfsdk:"comment"`
+	IsActivated               types.Bool            `tfsdk:"is_activated"`
+	Categories                types.Set             `tfsdk:"categories"`
+	CategoryNames             types.Set             `tfsdk:"category_names"`
+	Groups                    types.Set             `tfsdk:"groups"`
+	GroupNames                types.Set             `tfsdk:"group_names"`
+	ParentHostIDs             types.Set             `tfsdk:"parent_host_ids"`
+	ChildHostIDs              types.Set             `tfsdk:"child_host_ids"`
+	Templates                 types.List            `tfsdk:"templates"`
+	TemplateNames             types.List            `tfsdk:"template_names"`
+	Macros                    map[string]macroModel `tfsdk:"macros"`
+	GeoCoords                 types.String          `tfsdk:"geo_coords"`
+	WaitForStatus             *waitForStatusModel   `tfsdk:"wait_for_status"`
+}
+
+type waitForStatusModel struct {
+	States       types.Set   `tfsdk:"states"`
+	Timeout      types.Int64 `tfsdk:"timeout"`
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
 }
 
 type macroModel struct {
-	Name        types.String `tfsdk:"name"`
-	Value       types.String `tfsdk:"value"`
-	IsPassword  types.Bool   `tfsdk:"is_password"`
-	Description types.String `tfsdk:"description"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	IsPassword     types.Bool   `tfsdk:"is_password"`
+	Description    types.String `tfsdk:"description"`
 }
 
 func (r *hostResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,10 +118,26 @@ func (r *hostResource) Metadata(_ context.Context, req resource.MetadataRequest,
 func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Centreon host.",
+		Version:     3,
 		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Host ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"monitoring_server_id": schema.Int64Attribute{
-				Required:    true,
-				Description: "ID of the host's monitoring server",
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the host's monitoring server. Exactly one of monitoring_server_id or monitoring_server_name must be set",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitoring_server_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the host's monitoring server, resolved to monitoring_server_id",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -127,11 +175,21 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"notification_options": schema.Int64Attribute{
 				Optional:    true,
-				Description: "Notification options (sum of: 1=DOWN, 2=UNREACHABLE, 4=RECOVERY, 8=FLAPPING, 16=DOWNTIME_SCHEDULED)",
+				Computed:    true,
+				Description: "Notification options (sum of: 1=DOWN, 2=UNREACHABLE, 4=RECOVERY, 8=FLAPPING, 16=DOWNTIME_SCHEDULED). Conflicts with notification_types",
 				Validators: []validator.Int64{
 					validation.NotificationOptionsValidator{},
 				},
 			},
+			"notification_types": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Notification types, any of: down, unreachable, recovery, flapping, downtime_scheduled. Human-readable alternative to notification_options",
+				Validators: []validator.Set{
+					validation.NotificationTypesValidator{Types: validation.HostNotificationTypes},
+				},
+			},
 			"timezone_id": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Timezone ID",
@@ -156,36 +214,42 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"max_check_attempts": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Number of retry attempts for host checks",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
 			},
 			"normal_check_interval": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Interval between normal checks",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
 			},
 			"retry_check_interval": schema.Int64Attribute{
 				Optional:    true,
-				Description: "Interval between retry checks",
+				Description: "Interval between retry checks. Requires max_check_attempts to be set",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
 			},
-			"active_check_enabled": schema.Int64Attribute{
+			"active_check_enabled": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether active checks are enabled (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether active checks are enabled. Leave unset to inherit the value from the host templates",
 			},
-			"passive_check_enabled": schema.Int64Attribute{
+			"passive_check_enabled": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether passive checks are enabled (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether passive checks are enabled. Leave unset to inherit the value from the host templates",
 			},
-			"notification_enabled": schema.Int64Attribute{
+			"notification_enabled": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether notifications are enabled (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether notifications are enabled. Leave unset to inherit the value from the host templates",
 			},
 			"notification_interval": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Interval between notifications",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
 			},
 			"notification_timeperiod_id": schema.Int64Attribute{
 				Optional:    true,
@@ -194,44 +258,56 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"first_notification_delay": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Delay before first notification",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
 			},
 			"recovery_notification_delay": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Delay before recovery notification",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
 			},
 			"acknowledgement_timeout": schema.Int64Attribute{
 				Optional:    true,
 				Description: "Acknowledgement timeout",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
 			},
-			"freshness_checked": schema.Int64Attribute{
+			"freshness_checked": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether freshness is checked (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether freshness is checked. Leave unset to inherit the value from the host templates",
 			},
 			"freshness_threshold": schema.Int64Attribute{
 				Optional:    true,
-				Description: "Freshness threshold in seconds",
+				Description: "Freshness threshold in seconds. Requires freshness_checked to be true",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 0},
+				},
 			},
-			"flap_detection_enabled": schema.Int64Attribute{
+			"flap_detection_enabled": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether flap detection is enabled (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether flap detection is enabled. Leave unset to inherit the value from the host templates",
 			},
 			"low_flap_threshold": schema.Int64Attribute{
 				Optional:    true,
-				Description: "Low flap threshold",
+				Description: "Low flap threshold, in percent. Must not exceed high_flap_threshold",
+				Validators: []validator.Int64{
+					validation.Int64BetweenValidator{Min: 0, Max: 100},
+				},
 			},
 			"high_flap_threshold": schema.Int64Attribute{
 				Optional:    true,
-				Description: "High flap threshold",
+				Description: "High flap threshold, in percent",
+				Validators: []validator.Int64{
+					validation.Int64BetweenValidator{Min: 0, Max: 100},
+				},
 			},
-			"event_handler_enabled": schema.Int64Attribute{
+			"event_handler_enabled": schema.BoolAttribute{
 				Optional:    true,
-				Computed:    true,
-				Description: "Whether event handler is enabled (0=disabled, 1=enabled)",
-				Default:     int64default.StaticInt64(0),
+				Description: "Whether event handler is enabled. Leave unset to inherit the value from the host templates",
 			},
 			"event_handler_command_id": schema.Int64Attribute{
 				Optional:    true,
@@ -240,7 +316,7 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"event_handler_command_args": schema.ListAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
-				Description: "Event handler command arguments",
+				Description: "Event handler command arguments. Requires event_handler_command_id to be set",
 			},
 			"note_url": schema.StringAttribute{
 				Optional:    true,
@@ -266,37 +342,83 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Description: "Comments about the host",
 			},
-			"categories": schema.ListAttribute{
+			"categories": schema.SetAttribute{
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.Int64Type,
-				Description: "List of category IDs",
+				Description: "Set of category IDs. Conflicts with category_names",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"groups": schema.ListAttribute{
+			"category_names": schema.SetAttribute{
 				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Set of category names, resolved to categories",
+			},
+			"groups": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
 				ElementType: types.Int64Type,
-				Description: "List of group IDs",
+				Description: "Set of group IDs. Conflicts with group_names",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_names": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Set of group names, resolved to groups",
+			},
+			"parent_host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the parent hosts, used to compute the UNREACHABLE state and the network topology. Leave unset to not manage the parents. Cycles through hosts created in the same apply are only detected when applying",
+			},
+			"child_host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the child hosts. Leave unset to not manage the children, and don't also manage them through parent_host_ids of the child hosts",
 			},
 			"templates": schema.ListAttribute{
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.Int64Type,
-				Description: "List of template IDs",
+				Description: "Ordered list of template IDs, the first template having the highest priority for inheritance. Conflicts with template_names",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"macros": schema.ListNestedAttribute{
+			"template_names": schema.ListAttribute{
 				Optional:    true,
-				Description: "Host macros",
+				ElementType: types.StringType,
+				Description: "Ordered list of template names, resolved to templates",
+			},
+			"macros": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Host macros, keyed by macro name",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Required:    true,
-							Description: "Macro name",
-						},
 						"value": schema.StringAttribute{
-							Required:    true,
-							Description: "Macro value",
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Macro value, stored in state. Conflicts with value_wo and can't be set on password macros",
+						},
+						"value_wo": schema.StringAttribute{
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Description: "Write-only macro value, never stored in state. Requires value_wo_version and Terraform 1.11 or later",
+						},
+						"value_wo_version": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Version of value_wo. The write-only value is only sent when the macro is created or when its version changes",
 						},
 						"is_password": schema.BoolAttribute{
-							Required:    true,
-							Description: "Whether the macro value is a password",
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the macro value is a password. Password values are masked by Centreon and must be set with value_wo",
+							Default:     booldefault.StaticBool(false),
 						},
 						"description": schema.StringAttribute{
 							Optional:    true,
@@ -311,6 +433,41 @@ func (r *hostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Whether the host is activated",
 				Default:     booldefault.StaticBool(true),
 			},
+			"wait_for_status": schema.SingleNestedAttribute{
+				Optional: true,
+				Description: "Wait after create and update until the monitoring reports a check result of the host in one of the given states. " +
+					"Requires generate_and_reload_configuration, or another way to reload the configuration",
+				Attributes: map[string]schema.Attribute{
+					"states": schema.SetAttribute{
+						Optional:    true,
+						Computed:    true,
+						ElementType: types.StringType,
+						Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("up")})),
+						Description: "Accepted host states, among up, down and unreachable. Defaults to up",
+						Validators: []validator.Set{
+							validation.FailureCriteriaValidator{Criteria: validation.HostStates},
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(300),
+						Description: "Time to wait for the host status, in seconds. Defaults to 300",
+						Validators: []validator.Int64{
+							validation.Int64AtLeastValidator{Min: 1},
+						},
+					},
+					"poll_interval": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(10),
+						Description: "Time between two reads of the host status, in seconds. Defaults to 10",
+						Validators: []validator.Int64{
+							validation.Int64AtLeastValidator{Min: 1},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -332,14 +489,820 @@ func (r *hostResource) Configure(_ context.Context, req resource.ConfigureReques
 	r.client = client
 }
 
-// Helper function to handle configuration reload if enabled.
-func (r *hostResource) handleConfigurationReload() error {
-	if r.client.GenerateAndReloadConfiguration {
-		if err := r.client.ReloadConfiguration(); err != nil {
-			return fmt.Errorf("failed to generate and reload configuration: %v", err)
+// hostReferenceAttributes pairs the ID list attributes of the host with their
+// name-based alternatives.
+var hostReferenceAttributes = []struct {
+	ids   string
+	names string
+	empty attr.Value
+}{
+	{ids: "templates", names: "template_names", empty: listFromInts(nil)},
+	{ids: "groups", names: "group_names", empty: setFromInts(nil)},
+	{ids: "categories", names: "category_names", empty: setFromInts(nil)},
+}
+
+// ConfigValidators returns the cross-attribute rules of the host
+// configuration so that invalid combinations fail at plan time rather than
+// mid-apply.
+func (r *hostResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	validators := []resource.ConfigValidator{
+		validation.ExactlyOneOfValidator{
+			Paths: []path.Path{path.Root("monitoring_server_id"), path.Root("monitoring_server_name")},
+		},
+		validation.ConflictingAttributesValidator{
+			Paths: []path.Path{path.Root("notification_options"), path.Root("notification_types")},
+		},
+		validation.Int64LessThanOrEqualValidator{
+			Low:  path.Root("low_flap_threshold"),
+			High: path.Root("high_flap_threshold"),
+		},
+		validation.RequiresTrueValidator{
+			Attribute: path.Root("freshness_threshold"),
+			Required:  path.Root("freshness_checked"),
+		},
+		validation.RequiresAttributeValidator{
+			Attribute: path.Root("event_handler_command_args"),
+			Required:  path.Root("event_handler_command_id"),
+		},
+		validation.RequiresAttributeValidator{
+			Attribute: path.Root("retry_check_interval"),
+			Required:  path.Root("max_check_attempts"),
+		},
+	}
+
+	for _, ref := range hostReferenceAttributes {
+		validators = append(validators, validation.ConflictingAttributesValidator{
+			Paths: []path.Path{path.Root(ref.ids), path.Root(ref.names)},
+		})
+	}
+
+	return validators
+}
+
+// ValidateConfig checks the macros, whose value attributes can't be
+// expressed as resource-level validators since they are keyed by name.
+func (r *hostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var macros types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("macros"), &macros)...)
+	if !macros.IsNull() && !macros.IsUnknown() {
+		var models map[string]macroModel
+		resp.Diagnostics.Append(macros.ElementsAs(ctx, &models, false)...)
+		for name, m := range models {
+			if !m.Value.IsNull() && !m.ValueWO.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("macros").AtMapKey(name).AtName("value_wo"),
+					"Conflicting Macro Values",
+					fmt.Sprintf("Only one of value or value_wo can be set for macro %s.", name),
+				)
+			}
+			if m.IsPassword.ValueBool() && !m.Value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("macros").AtMapKey(name).AtName("value"),
+					"Password Macro Stored in State",
+					fmt.Sprintf("Macro %s is a password, set it with value_wo and value_wo_version so that it is never stored in state.", name),
+				)
+			}
+			if m.ValueWO.IsNull() != m.ValueWOVersion.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("macros").AtMapKey(name).AtName("value_wo_version"),
+					"Missing Macro Attribute",
+					fmt.Sprintf("value_wo and value_wo_version must be set together for macro %s.", name),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan resolves the name-based references to their IDs so that the plan
+// shows the IDs the host will actually be linked to.
+func (r *hostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planNotificationTypes(ctx, req, resp)...)
+	var id types.Int64
+	var parentSet, childSet types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parent_host_ids"), &parentSet)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("child_host_ids"), &childSet)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.checkHostRelations(id, parentSet, childSet)...)
+	resp.Diagnostics.Append(planUnsetReferences(ctx, req, resp)...)
+
+	// Only the reference attributes are read, the rest of the plan may still
+	// hold unknown values
+	var plan hostResourceModel
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("monitoring_server_id"), &plan.MonitoringServerID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("monitoring_server_name"), &plan.MonitoringServerName)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("templates"), &plan.Templates)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("template_names"), &plan.TemplateNames)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("groups"), &plan.Groups)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("group_names"), &plan.GroupNames)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("categories"), &plan.Categories)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("category_names"), &plan.CategoryNames)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("check_command_id"), &plan.CheckCommandID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Names are resolved again on every plan, so that renamed or recreated
+	// objects show up as changes. The planned IDs are used when applying,
+	// names are only resolved then when they were unknown.
+	plan.unknownNamedReferences()
+
+	// References can only be resolved once the provider is configured
+	if r.client != nil {
+		resp.Diagnostics.Append(r.resolveReferences(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if r.client.ValidateReferences {
+			resp.Diagnostics.Append(r.validateReferences(ctx, plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitoring_server_id"), plan.MonitoringServerID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("templates"), plan.Templates)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("groups"), plan.Groups)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("categories"), plan.Categories)...)
+}
+
+// planUnsetReferences plans the references that are configured neither by ID
+// nor by name as empty, so that removing them from the configuration clears
+// them rather than keeping the prior state.
+func planUnsetReferences(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, ref := range hostReferenceAttributes {
+		var ids, names attr.Value
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(ref.ids), &ids)...)
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(ref.names), &names)...)
+		if diags.HasError() {
+			return diags
+		}
+		if ids.IsNull() && names.IsNull() {
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root(ref.ids), ref.empty)...)
+		}
+	}
+
+	return diags
+}
+
+// planNotificationTypes keeps notification_options and notification_types in
+// sync by computing the one that is not configured from the other.
+func planNotificationTypes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var options types.Int64
+	var names types.Set
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("notification_options"), &options)...)
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("notification_types"), &names)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	switch {
+	case options.IsUnknown():
+		names = types.SetUnknown(types.StringType)
+	case names.IsUnknown():
+		options = types.Int64Unknown()
+	case !names.IsNull():
+		var values []string
+		diags.Append(names.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		mask, err := validation.NotificationTypesToBitmask(values, validation.HostNotificationTypes)
+		if err != nil {
+			// Already reported by the attribute validator
+			return diags
+		}
+		options = types.Int64Value(mask)
+	case !options.IsNull():
+		names = setFromStrings(validation.BitmaskToNotificationTypes(options.ValueInt64(), validation.HostNotificationTypes))
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("notification_options"), options)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("notification_types"), names)...)
+	return diags
+}
+
+// macrosFromModel converts the planned macros to API macros, sorted by name.
+// Write-only values are taken from the configuration as they are never part
+// of the plan.
+func macrosFromModel(plan, config map[string]macroModel) []client.HostMacro {
+	if len(plan) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(plan))
+	for name := range plan {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	macros := make([]client.HostMacro, len(names))
+	for i, name := range names {
+		m := plan[name]
+		macro := client.HostMacro{
+			Name:       name,
+			IsPassword: m.IsPassword.ValueBool(),
+		}
+		if !m.Value.IsNull() {
+			v := m.Value.ValueString()
+			macro.Value = &v
+		}
+		if wo := config[name].ValueWO; !wo.IsNull() {
+			v := wo.ValueString()
+			macro.Value = &v
+		}
+		if !m.Description.IsNull() {
+			v := m.Description.ValueString()
+			macro.Description = &v
+		}
+		macros[i] = macro
+	}
+	return macros
+}
+
+// resolveReferences sets the unknown monitoring server, template, group and
+// category IDs from their names. IDs stay unknown while their names are.
+func (r *hostResource) resolveReferences(ctx context.Context, model *hostResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.MonitoringServerID.IsUnknown() && !model.MonitoringServerName.IsNull() && !model.MonitoringServerName.IsUnknown() {
+		name := model.MonitoringServerName.ValueString()
+		server, err := r.client.FindMonitoringServerByName(name)
+		if err != nil {
+			diags.Append(referenceLookupError(path.Root("monitoring_server_name"), "monitoring server", name, err))
+		} else {
+			model.MonitoringServerID = types.Int64Value(int64(server.ID))
+		}
+	}
+
+	if model.Templates.IsUnknown() && !model.TemplateNames.IsNull() {
+		ids, known, d := r.resolveNames(ctx, model.TemplateNames, path.Root("template_names"), "host template",
+			func(name string) (int, error) {
+				template, err := r.client.FindHostTemplateByName(name)
+				if err != nil {
+					return 0, err
+				}
+				return template.ID, nil
+			})
+		diags.Append(d...)
+		if known {
+			model.Templates = listFromInts(ids)
+		}
+	}
+
+	if model.Groups.IsUnknown() && !model.GroupNames.IsNull() {
+		ids, known, d := r.resolveNames(ctx, model.GroupNames, path.Root("group_names"), "host group",
+			func(name string) (int, error) {
+				group, err := r.client.FindHostGroupByName(name)
+				if err != nil {
+					return 0, err
+				}
+				return group.ID, nil
+			})
+		diags.Append(d...)
+		if known {
+			model.Groups = setFromInts(ids)
+		}
+	}
+
+	if model.Categories.IsUnknown() && !model.CategoryNames.IsNull() {
+		ids, known, d := r.resolveNames(ctx, model.CategoryNames, path.Root("category_names"), "host category",
+			func(name string) (int, error) {
+				category, err := r.client.FindHostCategoryByName(name)
+				if err != nil {
+					return 0, err
+				}
+				return category.ID, nil
+			})
+		diags.Append(d...)
+		if known {
+			model.Categories = setFromInts(ids)
+		}
+	}
+
+	return diags
+}
+
+// checkHostRelations detects cycles in the parent/child relationships of a
+// host: references to the host itself, hosts that are both a parent and a
+// child, and children that are already ancestors of a parent.
+//
+// Only known IDs and the relationships already stored in Centreon are
+// checked. IDs of hosts created in the same apply are unknown when planning,
+// so cycles through them are only detected when applying, before the host is
+// written. Cycles made only of references between resources are rejected by
+// Terraform itself.
+func (r *hostResource) checkHostRelations(id types.Int64, parentSet, childSet types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parents := knownInt64s(parentSet)
+	children := knownInt64s(childSet)
+
+	for _, relation := range []struct {
+		attribute string
+		ids       []int64
+	}{
+		{attribute: "parent_host_ids", ids: parents},
+		{attribute: "child_host_ids", ids: children},
+	} {
+		for _, hostID := range relation.ids {
+			if !id.IsNull() && !id.IsUnknown() && hostID == id.ValueInt64() {
+				diags.AddAttributeError(
+					path.Root(relation.attribute).AtSetValue(types.Int64Value(hostID)),
+					"Host Relationship Cycle",
+					"A host can't be its own parent or child.",
+				)
+			}
+		}
+	}
+
+	isChild := make(map[int64]bool)
+	for _, child := range children {
+		isChild[child] = true
+	}
+	for _, parent := range parents {
+		if isChild[parent] {
+			diags.AddAttributeError(
+				path.Root("child_host_ids").AtSetValue(types.Int64Value(parent)),
+				"Host Relationship Cycle",
+				fmt.Sprintf("Host %d can't be both a parent and a child of the host.", parent),
+			)
+		}
+	}
+	if diags.HasError() || r.client == nil || len(parents) == 0 {
+		return diags
+	}
+
+	// The host itself and its children must not be ancestors of its parents
+	descendants := make(map[int64]bool, len(isChild)+1)
+	for child := range isChild {
+		descendants[child] = true
+	}
+	if !id.IsNull() && !id.IsUnknown() {
+		descendants[id.ValueInt64()] = true
+	}
+	if len(descendants) == 0 {
+		return diags
+	}
+
+	// Parents shared by several ancestors are only read once
+	hostParents := make(map[int][]int)
+	for _, parent := range parents {
+		ancestor, found, err := r.findAncestor(int(parent), descendants, hostParents)
+		if err != nil {
+			diags.AddAttributeWarning(
+				path.Root("parent_host_ids").AtSetValue(types.Int64Value(parent)),
+				"Unable to Check Host Relationships",
+				fmt.Sprintf("Could not read the ancestors of host %d: %v", parent, err),
+			)
+			continue
+		}
+		if found {
+			diags.AddAttributeError(
+				path.Root("parent_host_ids").AtSetValue(types.Int64Value(parent)),
+				"Host Relationship Cycle",
+				fmt.Sprintf("Host %d is already a descendant of this host through host %d.", parent, ancestor),
+			)
+		}
+	}
+
+	return diags
+}
+
+// maxHostAncestors is the maximum number of ancestors read when looking for
+// relationship cycles, so that plans of hosts with deep hierarchies stay fast.
+const maxHostAncestors = 100
+
+// findAncestor walks up the parents of the given host and returns the first
+// ancestor, the host included, that is one of the targets. The parents read
+// from the API are cached in hostParents.
+func (r *hostResource) findAncestor(hostID int, targets map[int64]bool, hostParents map[int][]int) (int, bool, error) {
+	visited := make(map[int]bool)
+	queue := []int{hostID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		if targets[int64(current)] {
+			return current, true, nil
+		}
+
+		parents, cached := hostParents[current]
+		if !cached {
+			if len(hostParents) >= maxHostAncestors {
+				return 0, false, fmt.Errorf("more than %d ancestors, stopped looking for cycles", maxHostAncestors)
+			}
+			var err error
+			parents, err = r.client.GetHostParents(current)
+			if err != nil && !client.IsNotFound(err) {
+				return 0, false, err
+			}
+			hostParents[current] = parents
+		}
+		queue = append(queue, parents...)
+	}
+	return 0, false, nil
+}
+
+// validateReferences checks that the IDs referenced by the plan exist.
+// References given by name were already looked up when resolving them and are
+// skipped.
+func (r *hostResource) validateReferences(ctx context.Context, plan hostResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.MonitoringServerName.IsNull() {
+		diags.Append(r.checkReference(path.Root("monitoring_server_id"), client.ReferenceMonitoringServer, "monitoring server", plan.MonitoringServerID)...)
+	}
+
+	if plan.TemplateNames.IsNull() && !plan.Templates.IsNull() && !plan.Templates.IsUnknown() {
+		var templates []types.Int64
+		diags.Append(plan.Templates.ElementsAs(ctx, &templates, false)...)
+		for i, id := range templates {
+			diags.Append(r.checkReference(path.Root("templates").AtListIndex(i), client.ReferenceHostTemplate, "host template", id)...)
+		}
+	}
+
+	if plan.GroupNames.IsNull() && !plan.Groups.IsNull() && !plan.Groups.IsUnknown() {
+		var groups []types.Int64
+		diags.Append(plan.Groups.ElementsAs(ctx, &groups, false)...)
+		for _, id := range groups {
+			diags.Append(r.checkReference(path.Root("groups").AtSetValue(id), client.ReferenceHostGroup, "host group", id)...)
+		}
+	}
+
+	diags.Append(r.checkReference(path.Root("check_command_id"), client.ReferenceCommand, "command", plan.CheckCommandID)...)
+
+	return diags
+}
+
+// checkReference reports an error on the given attribute when the referenced
+// object doesn't exist. Null and unknown IDs are not checked.
+func (r *hostResource) checkReference(attributePath path.Path, kind client.ReferenceKind, description string, id types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if id.IsNull() || id.IsUnknown() {
+		return diags
+	}
+
+	exists, err := r.client.ReferenceExists(kind, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Unable to Validate Reference",
+			fmt.Sprintf("Could not check that %s %d exists: %v", description, id.ValueInt64(), err),
+		)
+		return diags
+	}
+	if !exists {
+		diags.AddAttributeError(
+			attributePath,
+			"Missing Reference",
+			fmt.Sprintf("No %s with ID %d exists.", description, id.ValueInt64()),
+		)
+	}
+	return diags
+}
+
+// resolveNames returns the IDs matching the given list or set of names, in
+// the same order. It reports false when some of the names are not known yet.
+func (r *hostResource) resolveNames(ctx context.Context, names collectionValue, namesPath path.Path, kind string, lookup func(string) (int, error)) ([]int, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if names.IsUnknown() {
+		return nil, false, diags
+	}
+
+	var values []types.String
+	diags.Append(names.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	resolved := make([]int, 0, len(values))
+	for i, value := range values {
+		if value.IsUnknown() {
+			return nil, false, diags
+		}
+
+		elementPath := namesPath.AtListIndex(i)
+		if _, ok := names.(types.Set); ok {
+			elementPath = namesPath.AtSetValue(value)
+		}
+
+		id, err := lookup(value.ValueString())
+		if err != nil {
+			diags.Append(referenceLookupError(elementPath, kind, value.ValueString(), err))
+			continue
+		}
+		resolved = append(resolved, id)
+	}
+
+	return resolved, true, diags
+}
+
+// unknownNamedReferences marks the IDs of the references given by name as
+// unknown, so that resolveReferences looks them up.
+func (m *hostResourceModel) unknownNamedReferences() {
+	if !m.MonitoringServerName.IsNull() {
+		m.MonitoringServerID = types.Int64Unknown()
+	}
+	if !m.TemplateNames.IsNull() {
+		m.Templates = types.ListUnknown(types.Int64Type)
+	}
+	if !m.GroupNames.IsNull() {
+		m.Groups = types.SetUnknown(types.Int64Type)
+	}
+	if !m.CategoryNames.IsNull() {
+		m.Categories = types.SetUnknown(types.Int64Type)
+	}
+}
+
+// referenceLookupError converts a failed lookup by name into a diagnostic.
+func referenceLookupError(attributePath path.Path, kind, name string, err error) diag.Diagnostic {
+	if client.IsNotFound(err) {
+		return diag.NewAttributeErrorDiagnostic(
+			attributePath,
+			"Unknown "+kind,
+			fmt.Sprintf("No %s named %q was found in Centreon.", kind, name),
+		)
+	}
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Error resolving "+kind,
+		fmt.Sprintf("Could not look up %s %q: %v", kind, name, err),
+	)
+}
+
+// hostPatch returns the fields that differ between the state and the plan.
+func hostPatch(ctx context.Context, state, plan hostResourceModel, configMacros map[string]macroModel) (client.HostPatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	patch := client.HostPatch{}
+
+	patchInt64(patch, "monitoring_server_id", state.MonitoringServerID, plan.MonitoringServerID)
+	patchString(patch, "name", state.Name, plan.Name)
+	patchString(patch, "address", state.Address, plan.Address)
+	patchString(patch, "alias", state.Alias, plan.Alias)
+	patchString(patch, "snmp_community", state.SNMPCommunity, plan.SNMPCommunity)
+	patchString(patch, "snmp_version", state.SNMPVersion, plan.SNMPVersion)
+	patchInt64(patch, "timezone_id", state.TimezoneID, plan.TimezoneID)
+	patchInt64(patch, "severity_id", state.SeverityID, plan.SeverityID)
+	patchInt64(patch, "check_command_id", state.CheckCommandID, plan.CheckCommandID)
+	patchStrings(patch, "check_command_args", state.CheckCommandArgs, plan.CheckCommandArgs)
+	patchInt64(patch, "check_timeperiod_id", state.CheckTimeperiodID, plan.CheckTimeperiodID)
+	patchInt64(patch, "max_check_attempts", state.MaxCheckAttempts, plan.MaxCheckAttempts)
+	patchInt64(patch, "normal_check_interval", state.NormalCheckInterval, plan.NormalCheckInterval)
+	patchInt64(patch, "retry_check_interval", state.RetryCheckInterval, plan.RetryCheckInterval)
+	patchTriState(patch, "active_check_enabled", state.ActiveCheckEnabled, plan.ActiveCheckEnabled)
+	patchTriState(patch, "passive_check_enabled", state.PassiveCheckEnabled, plan.PassiveCheckEnabled)
+	patchTriState(patch, "notification_enabled", state.NotificationEnabled, plan.NotificationEnabled)
+	patchInt64(patch, "notification_options", state.NotificationOptions, plan.NotificationOptions)
+	patchInt64(patch, "notification_interval", state.NotificationInterval, plan.NotificationInterval)
+	patchInt64(patch, "notification_timeperiod_id", state.NotificationTimeperiodID, plan.NotificationTimeperiodID)
+	patchInt64(patch, "first_notification_delay", state.FirstNotificationDelay, plan.FirstNotificationDelay)
+	patchInt64(patch, "recovery_notification_delay", state.RecoveryNotificationDelay, plan.RecoveryNotificationDelay)
+	patchInt64(patch, "acknowledgement_timeout", state.AcknowledgementTimeout, plan.AcknowledgementTimeout)
+	patchTriState(patch, "freshness_checked", state.FreshnessChecked, plan.FreshnessChecked)
+	patchInt64(patch, "freshness_threshold", state.FreshnessThreshold, plan.FreshnessThreshold)
+	patchTriState(patch, "flap_detection_enabled", state.FlapDetectionEnabled, plan.FlapDetectionEnabled)
+	patchInt64(patch, "low_flap_threshold", state.LowFlapThreshold, plan.LowFlapThreshold)
+	patchInt64(patch, "high_flap_threshold", state.HighFlapThreshold, plan.HighFlapThreshold)
+	patchTriState(patch, "event_handler_enabled", state.EventHandlerEnabled, plan.EventHandlerEnabled)
+	patchInt64(patch, "event_handler_command_id", state.EventHandlerCommandID, plan.EventHandlerCommandID)
+	patchStrings(patch, "event_handler_command_args", state.EventHandlerCommandArgs, plan.EventHandlerCommandArgs)
+	patchString(patch, "note_url", state.NoteURL, plan.NoteURL)
+	patchString(patch, "note", state.Note, plan.Note)
+	patchString(patch, "action_url", state.ActionURL, plan.ActionURL)
+	patchInt64(patch, "icon_id", state.IconID, plan.IconID)
+	patchString(patch, "icon_alternative", state.IconAlternative, plan.IconAlternative)
+	patchString(patch, "comment", state.Comment, plan.Comment)
+	patchString(patch, "geo_coords", state.GeoCoords, plan.GeoCoords)
+
+	if !plan.IsActivated.Equal(state.IsActivated) {
+		patch["is_activated"] = plan.IsActivated.ValueBool()
+	}
+
+	// ID lists are always sent as lists, an empty list clears the links
+	if !plan.Categories.Equal(state.Categories) {
+		categories, d := intsFromSet(ctx, plan.Categories)
+		diags.Append(d...)
+		patch["categories"] = nonNilInts(categories)
+	}
+	if !plan.Groups.Equal(state.Groups) {
+		groups, d := intsFromSet(ctx, plan.Groups)
+		diags.Append(d...)
+		patch["groups"] = nonNilInts(groups)
+	}
+	if !plan.Templates.Equal(state.Templates) {
+		templates, d := intsFromList(ctx, plan.Templates)
+		diags.Append(d...)
+		patch["templates"] = nonNilInts(templates)
+	}
+
+	// Macros are replaced as a whole as soon as one of them changed
+	if !macrosEqual(state.Macros, plan.Macros) {
+		macros := macrosFromModel(plan.Macros, configMacros)
+		if macros == nil {
+			macros = []client.HostMacro{}
+		}
+		patch["macros"] = macros
+	}
+
+	return patch, diags
+}
+
+// patchString adds a string field to the patch when it changed.
+func patchString(patch client.HostPatch, field string, state, plan types.String) {
+	if plan.Equal(state) {
+		return
+	}
+	if plan.IsNull() {
+		patch[field] = nil
+		return
+	}
+	patch[field] = plan.ValueString()
+}
+
+// patchInt64 adds an integer field to the patch when it changed.
+func patchInt64(patch client.HostPatch, field string, state, plan types.Int64) {
+	if plan.Equal(state) {
+		return
+	}
+	if plan.IsNull() {
+		patch[field] = nil
+		return
+	}
+	patch[field] = plan.ValueInt64()
+}
+
+// patchTriState adds an enabled/disabled/inherited field to the patch when it
+// changed. A removed value is sent as inherited rather than null.
+func patchTriState(patch client.HostPatch, field string, state, plan types.Bool) {
+	if plan.Equal(state) {
+		return
+	}
+	patch[field] = triStateFromBool(plan)
+}
+
+// patchStrings adds a string list field to the patch when it changed. A
+// removed list is sent as an empty list.
+func patchStrings(patch client.HostPatch, field string, state, plan []types.String) {
+	if len(state) == len(plan) {
+		changed := false
+		for i := range plan {
+			if !plan[i].Equal(state[i]) {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+
+	values := make([]string, len(plan))
+	for i, v := range plan {
+		values[i] = v.ValueString()
+	}
+	patch[field] = values
+}
+
+// nonNilInts returns an empty slice instead of nil, so that it is sent as an
+// empty JSON list rather than null.
+func nonNilInts(ints []int) []int {
+	if ints == nil {
+		return []int{}
+	}
+	return ints
+}
+
+// macrosEqual reports whether two sets of macros are identical, including the
+// versions of their write-only values.
+func macrosEqual(a, b map[string]macroModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, m := range a {
+		other, ok := b[name]
+		if !ok {
+			return false
+		}
+		if !m.Value.Equal(other.Value) || !m.ValueWOVersion.Equal(other.ValueWOVersion) ||
+			!m.IsPassword.Equal(other.IsPassword) || !m.Description.Equal(other.Description) {
+			return false
+		}
+	}
+	return true
+}
+
+// updateHostRelations replaces the parents and children of the host when they
+// are managed and changed.
+func (r *hostResource) updateHostRelations(ctx context.Context, hostID int, state, plan hostResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.ParentHostIDs.IsNull() && !plan.ParentHostIDs.Equal(state.ParentHostIDs) {
+		ids, d := intsFromSet(ctx, plan.ParentHostIDs)
+		diags.Append(d...)
+		if err := r.client.SetHostParents(hostID, ids); err != nil {
+			diags.AddAttributeError(
+				path.Root("parent_host_ids"),
+				"Error updating host parents",
+				fmt.Sprintf("Could not update the parents of host %s: %v", plan.Name.ValueString(), err),
+			)
+		}
+	}
+
+	if !plan.ChildHostIDs.IsNull() && !plan.ChildHostIDs.Equal(state.ChildHostIDs) {
+		ids, d := intsFromSet(ctx, plan.ChildHostIDs)
+		diags.Append(d...)
+		if err := r.client.SetHostChildren(hostID, ids); err != nil {
+			diags.AddAttributeError(
+				path.Root("child_host_ids"),
+				"Error updating host children",
+				fmt.Sprintf("Could not update the children of host %s: %v", plan.Name.ValueString(), err),
+			)
 		}
 	}
-	return nil
+
+	return diags
+}
+
+// waitForStatus waits until the monitoring reports a check result of the host
+// in one of the states of wait_for_status, if set.
+func (r *hostResource) waitForStatus(ctx context.Context, hostID int, plan hostResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.WaitForStatus == nil {
+		return diags
+	}
+
+	var names []string
+	diags.Append(plan.WaitForStatus.States.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	accepted := make(map[string]bool)
+	for _, option := range validation.HostStates {
+		for _, name := range names {
+			if option.Name == name {
+				accepted[option.Code] = true
+			}
+		}
+	}
+
+	timeout := time.Duration(plan.WaitForStatus.Timeout.ValueInt64()) * time.Second
+	interval := time.Duration(plan.WaitForStatus.PollInterval.ValueInt64()) * time.Second
+
+	logging.Info(ctx, "Waiting for host status", map[string]interface{}{
+		"host":    plan.Name.ValueString(),
+		"states":  names,
+		"timeout": timeout.String(),
+	})
+
+	err := waitFor(ctx, timeout, interval, func() (bool, error) {
+		state, err := r.client.GetHostState(hostID)
+		if client.IsNotFound(err) {
+			return false, fmt.Errorf("host is not monitored yet, check that the configuration was reloaded")
+		}
+		if err != nil {
+			return false, err
+		}
+		if state.LastCheck == nil || state.LastCheck.IsZero() {
+			return false, fmt.Errorf("host was not checked yet (%s)", state.Status.Name)
+		}
+		if !accepted[state.Status.Name] {
+			return false, fmt.Errorf("host is %s: %s", state.Status.Name, state.Information)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("wait_for_status"),
+			"Host status not reached",
+			fmt.Sprintf("Host %s didn't reach one of the states %s within %s: %v",
+				plan.Name.ValueString(), strings.Join(names, ", "), timeout, err),
+		)
+	}
+	return diags
+}
+
+// Helper function to handle configuration reload if enabled.
+func (r *hostResource) handleConfigurationReload() error {
+	return handleConfigurationReload(r.client)
 }
 
 func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -349,6 +1312,20 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Resolve the names that were still unknown at plan time, the IDs
+	// resolved when planning are kept
+	resp.Diagnostics.Append(r.resolveReferences(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Parents and children created in the same apply were unknown when
+	// planning, check the relationships again before creating the host
+	resp.Diagnostics.Append(r.checkHostRelations(plan.ID, plan.ParentHostIDs, plan.ChildHostIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert the plan model to a CreateHostRequest
 	createReq := &client.CreateHostRequest{
 		MonitoringServerID: int(plan.MonitoringServerID.ValueInt64()),
@@ -404,16 +1381,16 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		v := int(plan.RetryCheckInterval.ValueInt64())
 		createReq.RetryCheckInterval = &v
 	}
-	if !plan.ActiveCheckEnabled.IsNull() && plan.ActiveCheckEnabled.ValueInt64() != 0 {
-		v := int(plan.ActiveCheckEnabled.ValueInt64())
+	if !plan.ActiveCheckEnabled.IsNull() {
+		v := triStateFromBool(plan.ActiveCheckEnabled)
 		createReq.ActiveCheckEnabled = &v
 	}
-	if !plan.PassiveCheckEnabled.IsNull() && plan.PassiveCheckEnabled.ValueInt64() != 0 {
-		v := int(plan.PassiveCheckEnabled.ValueInt64())
+	if !plan.PassiveCheckEnabled.IsNull() {
+		v := triStateFromBool(plan.PassiveCheckEnabled)
 		createReq.PassiveCheckEnabled = &v
 	}
-	if !plan.NotificationEnabled.IsNull() && plan.NotificationEnabled.ValueInt64() != 0 {
-		v := int(plan.NotificationEnabled.ValueInt64())
+	if !plan.NotificationEnabled.IsNull() {
+		v := triStateFromBool(plan.NotificationEnabled)
 		createReq.NotificationEnabled = &v
 	}
 	if !plan.NotificationOptions.IsNull() {
@@ -440,16 +1417,16 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		v := int(plan.AcknowledgementTimeout.ValueInt64())
 		createReq.AcknowledgementTimeout = &v
 	}
-	if !plan.FreshnessChecked.IsNull() && plan.FreshnessChecked.ValueInt64() != 0 {
-		v := int(plan.FreshnessChecked.ValueInt64())
+	if !plan.FreshnessChecked.IsNull() {
+		v := triStateFromBool(plan.FreshnessChecked)
 		createReq.FreshnessChecked = &v
 	}
 	if !plan.FreshnessThreshold.IsNull() {
 		v := int(plan.FreshnessThreshold.ValueInt64())
 		createReq.FreshnessThreshold = &v
 	}
-	if !plan.FlapDetectionEnabled.IsNull() && plan.FlapDetectionEnabled.ValueInt64() != 0 {
-		v := int(plan.FlapDetectionEnabled.ValueInt64())
+	if !plan.FlapDetectionEnabled.IsNull() {
+		v := triStateFromBool(plan.FlapDetectionEnabled)
 		createReq.FlapDetectionEnabled = &v
 	}
 	if !plan.LowFlapThreshold.IsNull() {
@@ -460,8 +1437,8 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		v := int(plan.HighFlapThreshold.ValueInt64())
 		createReq.HighFlapThreshold = &v
 	}
-	if !plan.EventHandlerEnabled.IsNull() && plan.EventHandlerEnabled.ValueInt64() != 0 {
-		v := int(plan.EventHandlerEnabled.ValueInt64())
+	if !plan.EventHandlerEnabled.IsNull() {
+		v := triStateFromBool(plan.EventHandlerEnabled)
 		createReq.EventHandlerEnabled = &v
 	}
 	if !plan.EventHandlerCommandID.IsNull() {
@@ -509,47 +1486,26 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Convert slice fields
-	if len(plan.Categories) > 0 {
-		categories := make([]int, len(plan.Categories))
-		for i, cat := range plan.Categories {
-			categories[i] = int(cat.ValueInt64())
-		}
-		createReq.Categories = categories
-	}
-	if len(plan.Groups) > 0 {
-		groups := make([]int, len(plan.Groups))
-		for i, grp := range plan.Groups {
-			groups[i] = int(grp.ValueInt64())
-		}
-		createReq.Groups = groups
-	}
-	if len(plan.Templates) > 0 {
-		templates := make([]int, len(plan.Templates))
-		for i, tpl := range plan.Templates {
-			templates[i] = int(tpl.ValueInt64())
-		}
-		createReq.Templates = templates
+	categories, diags := intsFromSet(ctx, plan.Categories)
+	resp.Diagnostics.Append(diags...)
+	groups, diags := intsFromSet(ctx, plan.Groups)
+	resp.Diagnostics.Append(diags...)
+	templates, diags := intsFromList(ctx, plan.Templates)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	createReq.Categories = categories
+	createReq.Groups = groups
+	createReq.Templates = templates
 
-	// Convert macros
-	if len(plan.Macros) > 0 {
-		createReq.Macros = make([]client.HostMacro, len(plan.Macros))
-		for i, m := range plan.Macros {
-			macro := client.HostMacro{
-				Name:       m.Name.ValueString(),
-				IsPassword: m.IsPassword.ValueBool(),
-			}
-			if !m.Value.IsNull() {
-				v := m.Value.ValueString()
-				macro.Value = &v
-			}
-			if !m.Description.IsNull() {
-				v := m.Description.ValueString()
-				macro.Description = &v
-			}
-			createReq.Macros[i] = macro
-		}
+	// Convert macros, write-only values are only available in the configuration
+	var configMacros map[string]macroModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("macros"), &configMacros)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	createReq.Macros = macrosFromModel(plan.Macros, configMacros)
 
 	logging.Info(ctx, "Creating host", map[string]interface{}{
 		"name": createReq.Name,
@@ -568,6 +1524,26 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// The API doesn't return the created host, look its ID up by name
+	hostID, err := r.client.FindHostIDByName(createReq.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host",
+			fmt.Sprintf("Could not read host %s after creation: %v", createReq.Name, err),
+		)
+		return
+	}
+	plan.ID = types.Int64Value(int64(hostID))
+
+	// Save the host before linking it, so that it is tainted rather than
+	// orphaned if linking fails
+	relationDiags := r.updateHostRelations(ctx, hostID, hostResourceModel{}, plan)
+	if relationDiags.HasError() {
+		resp.Diagnostics.Append(relationDiags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
 	// Generate and reload configuration if enabled
 	if err := r.handleConfigurationReload(); err != nil {
 		resp.Diagnostics.AddError(
@@ -577,7 +1553,9 @@ func (r *hostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Save the plan
+	// Save the plan, even if the host doesn't reach the awaited status so
+	// that it is tainted
+	resp.Diagnostics.Append(r.waitForStatus(ctx, hostID, plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -606,10 +1584,16 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	host := hosts.Result[0]
 
 	// Update state with values from API, only if they differ from defaults
+	state.ID = types.Int64Value(int64(host.ID))
 	state.Name = types.StringValue(host.Name)
 	state.Address = types.StringValue(host.Address)
-	state.Alias = types.StringValue(host.Alias)
+	if host.Alias != "" || !state.Alias.IsNull() {
+		state.Alias = types.StringValue(host.Alias)
+	}
 	state.MonitoringServerID = types.Int64Value(int64(host.MonitoringServer.ID))
+	if !state.MonitoringServerName.IsNull() {
+		state.MonitoringServerName = types.StringValue(host.MonitoringServer.Name)
+	}
 
 	// Only set if not empty/default
 	if host.SNMPCommunity != "" {
@@ -642,6 +1626,11 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if host.NotificationOptions != 0 {
 		state.NotificationOptions = types.Int64Value(int64(host.NotificationOptions))
 	}
+	state.NotificationTypes = types.SetNull(types.StringType)
+	if !state.NotificationOptions.IsNull() {
+		state.NotificationTypes = setFromStrings(validation.BitmaskToNotificationTypes(
+			state.NotificationOptions.ValueInt64(), validation.HostNotificationTypes))
+	}
 	if host.NotificationInterval != 0 {
 		state.NotificationInterval = types.Int64Value(int64(host.NotificationInterval))
 	}
@@ -694,34 +1683,38 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.GeoCoords = types.StringValue(host.GeoCoords)
 	}
 
-	// Handle special fields with default value of 2
-	if host.ActiveCheckEnabled != 2 {
-		state.ActiveCheckEnabled = types.Int64Value(int64(host.ActiveCheckEnabled))
-	}
-	if host.PassiveCheckEnabled != 2 {
-		state.PassiveCheckEnabled = types.Int64Value(int64(host.PassiveCheckEnabled))
-	}
-	if host.NotificationEnabled != 2 {
-		state.NotificationEnabled = types.Int64Value(int64(host.NotificationEnabled))
-	}
-	if host.EventHandlerEnabled != 2 {
-		state.EventHandlerEnabled = types.Int64Value(int64(host.EventHandlerEnabled))
-	}
-	if host.FlapDetectionEnabled != 2 {
-		state.FlapDetectionEnabled = types.Int64Value(int64(host.FlapDetectionEnabled))
-	}
-	if host.FreshnessChecked != 2 {
-		state.FreshnessChecked = types.Int64Value(int64(host.FreshnessChecked))
+	// Enabled/checked fields are null when inherited from the templates
+	state.ActiveCheckEnabled = boolFromTriState(host.ActiveCheckEnabled)
+	state.PassiveCheckEnabled = boolFromTriState(host.PassiveCheckEnabled)
+	state.NotificationEnabled = boolFromTriState(host.NotificationEnabled)
+	state.EventHandlerEnabled = boolFromTriState(host.EventHandlerEnabled)
+	state.FlapDetectionEnabled = boolFromTriState(host.FlapDetectionEnabled)
+	state.FreshnessChecked = boolFromTriState(host.FreshnessChecked)
+
+	// Parents and children are only refreshed when managed by this resource
+	if !state.ParentHostIDs.IsNull() {
+		parents, err := r.client.GetHostParents(host.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading host parents",
+				fmt.Sprintf("Could not read the parents of host %s: %v", host.Name, err),
+			)
+			return
+		}
+		state.ParentHostIDs = setFromInts(parents)
+	}
+	if !state.ChildHostIDs.IsNull() {
+		children, err := r.client.GetHostChildren(host.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading host children",
+				fmt.Sprintf("Could not read the children of host %s: %v", host.Name, err),
+			)
+			return
+		}
+		state.ChildHostIDs = setFromInts(children)
 	}
 
-	// Handle enabled/checked fields with default value of 0
-	state.ActiveCheckEnabled = types.Int64Value(int64(host.ActiveCheckEnabled))
-	state.PassiveCheckEnabled = types.Int64Value(int64(host.PassiveCheckEnabled))
-	state.NotificationEnabled = types.Int64Value(int64(host.NotificationEnabled))
-	state.EventHandlerEnabled = types.Int64Value(int64(host.EventHandlerEnabled))
-	state.FlapDetectionEnabled = types.Int64Value(int64(host.FlapDetectionEnabled))
-	state.FreshnessChecked = types.Int64Value(int64(host.FreshnessChecked))
-
 	// Only set arrays if not empty
 	if len(host.CheckCommandArgs) > 0 {
 		state.CheckCommandArgs = make([]types.String, len(host.CheckCommandArgs))
@@ -737,22 +1730,31 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 	}
 
-	if len(host.Categories) > 0 {
-		state.Categories = make([]types.Int64, len(host.Categories))
-		for i, cat := range host.Categories {
-			state.Categories[i] = types.Int64Value(int64(cat))
-		}
-	}
-
 	// Always set these fields as they are required
-	state.Groups = make([]types.Int64, len(host.Groups))
+	state.Categories = setFromInts(host.Categories)
+
+	groupIDs := make([]int, len(host.Groups))
+	groupNames := make([]string, len(host.Groups))
 	for i, group := range host.Groups {
-		state.Groups[i] = types.Int64Value(int64(group.ID))
+		groupIDs[i] = group.ID
+		groupNames[i] = group.Name
 	}
+	state.Groups = setFromInts(groupIDs)
 
-	state.Templates = make([]types.Int64, len(host.Templates))
+	templateIDs := make([]int, len(host.Templates))
+	templateNames := make([]string, len(host.Templates))
 	for i, tmpl := range host.Templates {
-		state.Templates[i] = types.Int64Value(int64(tmpl.ID))
+		templateIDs[i] = tmpl.ID
+		templateNames[i] = tmpl.Name
+	}
+	state.Templates = listFromInts(templateIDs)
+
+	// Names are only tracked when they are used in the configuration
+	if !state.GroupNames.IsNull() {
+		state.GroupNames = setFromStrings(groupNames)
+	}
+	if !state.TemplateNames.IsNull() {
+		state.TemplateNames = listFromStrings(templateNames)
 	}
 
 	// Get macros for the host
@@ -763,17 +1765,24 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			"error":   err.Error(),
 		})
 	} else if len(macros) > 0 {
-		state.Macros = make([]macroModel, len(macros))
-		for i, m := range macros {
+		prior := state.Macros
+		state.Macros = make(map[string]macroModel, len(macros))
+		for _, m := range macros {
 			mac := macroModel{
-				Name:       types.StringValue(m.Name),
-				IsPassword: types.BoolValue(m.IsPassword),
+				IsPassword:     types.BoolValue(m.IsPassword),
+				ValueWO:        types.StringNull(),
+				ValueWOVersion: types.Int64Null(),
 			}
 
-			// According to API docs, if is_password is true and value is null,
-			// the value is considered unchanged. However, for our purposes,
-			// we should retrieve the value if possible
-			if m.Value != nil {
+			// Write-only values are never stored, only their version kept
+			// from the prior state, and password values are masked by the API
+			// and never stored either.
+			previous, known := prior[m.Name]
+			switch {
+			case known && !previous.ValueWOVersion.IsNull():
+				mac.ValueWOVersion = previous.ValueWOVersion
+			case m.IsPassword:
+			case m.Value != nil:
 				mac.Value = types.StringValue(*m.Value)
 			}
 
@@ -781,13 +1790,12 @@ func (r *hostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 				mac.Description = types.StringValue(*m.Description)
 			}
 
-			state.Macros[i] = mac
+			state.Macros[m.Name] = mac
 		}
 
 		logging.Info(ctx, "Host macros retrieved", map[string]interface{}{
-			"host":   host.Name,
-			"count":  len(macros),
-			"macros": macros,
+			"host":  host.Name,
+			"count": len(macros),
 		})
 	}
 
@@ -810,228 +1818,67 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	logging.Info(ctx, "Updating host", map[string]interface{}{
-		"host": plan.Name.ValueString(),
-	})
-
-	// Create update request using the same structure as create
-	updateReq := &client.CreateHostRequest{
-		MonitoringServerID: int(plan.MonitoringServerID.ValueInt64()),
-		Name:               plan.Name.ValueString(),
-		Address:            plan.Address.ValueString(),
-	}
-
-	// Only include fields that are actually set in the plan
-	if !plan.Alias.IsNull() {
-		v := plan.Alias.ValueString()
-		updateReq.Alias = &v
-	}
-	if !plan.SNMPCommunity.IsNull() {
-		v := plan.SNMPCommunity.ValueString()
-		updateReq.SNMPCommunity = &v
-	}
-	if !plan.SNMPVersion.IsNull() {
-		v := plan.SNMPVersion.ValueString()
-		updateReq.SNMPVersion = &v
-	}
-	if !plan.TimezoneID.IsNull() {
-		v := int(plan.TimezoneID.ValueInt64())
-		updateReq.TimezoneID = &v
-	}
-	if !plan.SeverityID.IsNull() {
-		v := int(plan.SeverityID.ValueInt64())
-		updateReq.SeverityID = &v
-	}
-	if !plan.CheckCommandID.IsNull() {
-		v := int(plan.CheckCommandID.ValueInt64())
-		updateReq.CheckCommandID = &v
-	}
-	if !plan.CheckTimeperiodID.IsNull() {
-		v := int(plan.CheckTimeperiodID.ValueInt64())
-		updateReq.CheckTimeperiodID = &v
-	}
-	if !plan.MaxCheckAttempts.IsNull() {
-		v := int(plan.MaxCheckAttempts.ValueInt64())
-		updateReq.MaxCheckAttempts = &v
-	}
-	if !plan.NormalCheckInterval.IsNull() {
-		v := int(plan.NormalCheckInterval.ValueInt64())
-		updateReq.NormalCheckInterval = &v
-	}
-	if !plan.RetryCheckInterval.IsNull() {
-		v := int(plan.RetryCheckInterval.ValueInt64())
-		updateReq.RetryCheckInterval = &v
-	}
-	if !plan.ActiveCheckEnabled.IsNull() {
-		v := int(plan.ActiveCheckEnabled.ValueInt64())
-		updateReq.ActiveCheckEnabled = &v
-	}
-	if !plan.PassiveCheckEnabled.IsNull() {
-		v := int(plan.PassiveCheckEnabled.ValueInt64())
-		updateReq.PassiveCheckEnabled = &v
-	}
-	if !plan.NotificationEnabled.IsNull() {
-		v := int(plan.NotificationEnabled.ValueInt64())
-		updateReq.NotificationEnabled = &v
-	}
-	if !plan.NotificationOptions.IsNull() {
-		v := int(plan.NotificationOptions.ValueInt64())
-		updateReq.NotificationOptions = &v
-	}
-	if !plan.NotificationInterval.IsNull() {
-		v := int(plan.NotificationInterval.ValueInt64())
-		updateReq.NotificationInterval = &v
-	}
-	if !plan.NotificationTimeperiodID.IsNull() {
-		v := int(plan.NotificationTimeperiodID.ValueInt64())
-		updateReq.NotificationTimeperiodID = &v
-	}
-	if !plan.FirstNotificationDelay.IsNull() {
-		v := int(plan.FirstNotificationDelay.ValueInt64())
-		updateReq.FirstNotificationDelay = &v
-	}
-	if !plan.RecoveryNotificationDelay.IsNull() {
-		v := int(plan.RecoveryNotificationDelay.ValueInt64())
-		updateReq.RecoveryNotificationDelay = &v
-	}
-	if !plan.AcknowledgementTimeout.IsNull() {
-		v := int(plan.AcknowledgementTimeout.ValueInt64())
-		updateReq.AcknowledgementTimeout = &v
-	}
-	if !plan.FreshnessChecked.IsNull() {
-		v := int(plan.FreshnessChecked.ValueInt64())
-		updateReq.FreshnessChecked = &v
-	}
-	if !plan.FreshnessThreshold.IsNull() {
-		v := int(plan.FreshnessThreshold.ValueInt64())
-		updateReq.FreshnessThreshold = &v
-	}
-	if !plan.FlapDetectionEnabled.IsNull() {
-		v := int(plan.FlapDetectionEnabled.ValueInt64())
-		updateReq.FlapDetectionEnabled = &v
-	}
-	if !plan.LowFlapThreshold.IsNull() {
-		v := int(plan.LowFlapThreshold.ValueInt64())
-		updateReq.LowFlapThreshold = &v
-	}
-	if !plan.HighFlapThreshold.IsNull() {
-		v := int(plan.HighFlapThreshold.ValueInt64())
-		updateReq.HighFlapThreshold = &v
-	}
-	if !plan.EventHandlerEnabled.IsNull() {
-		v := int(plan.EventHandlerEnabled.ValueInt64())
-		updateReq.EventHandlerEnabled = &v
-	}
-	if !plan.EventHandlerCommandID.IsNull() {
-		v := int(plan.EventHandlerCommandID.ValueInt64())
-		updateReq.EventHandlerCommandID = &v
+	// Resolve the names that were still unknown at plan time, the IDs
+	// resolved when planning are kept
+	resp.Diagnostics.Append(r.resolveReferences(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Only include non-empty arrays
-	if len(plan.CheckCommandArgs) > 0 {
-		args := make([]string, len(plan.CheckCommandArgs))
-		for i, arg := range plan.CheckCommandArgs {
-			args[i] = arg.ValueString()
+	// Parents and children created in the same apply were unknown when
+	// planning, check the relationships again before updating the host
+	if !plan.ParentHostIDs.Equal(state.ParentHostIDs) || !plan.ChildHostIDs.Equal(state.ChildHostIDs) {
+		resp.Diagnostics.Append(r.checkHostRelations(plan.ID, plan.ParentHostIDs, plan.ChildHostIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		updateReq.CheckCommandArgs = args
-	}
-	if len(plan.EventHandlerCommandArgs) > 0 {
-		args := make([]string, len(plan.EventHandlerCommandArgs))
-		for i, arg := range plan.EventHandlerCommandArgs {
-			args[i] = arg.ValueString()
-		}
-		updateReq.EventHandlerCommandArgs = args
-	}
-	if len(plan.Categories) > 0 {
-		categories := make([]int, len(plan.Categories))
-		for i, cat := range plan.Categories {
-			categories[i] = int(cat.ValueInt64())
-		}
-		updateReq.Categories = categories
 	}
 
-	// Always include groups and templates as they are required
-	if len(plan.Groups) > 0 {
-		groups := make([]int, len(plan.Groups))
-		for i, grp := range plan.Groups {
-			groups[i] = int(grp.ValueInt64())
-		}
-		updateReq.Groups = groups
-	}
-	if len(plan.Templates) > 0 {
-		templates := make([]int, len(plan.Templates))
-		for i, tpl := range plan.Templates {
-			templates[i] = int(tpl.ValueInt64())
-		}
-		updateReq.Templates = templates
+	// Write-only macro values are only available in the configuration
+	var configMacros map[string]macroModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("macros"), &configMacros)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Only include non-empty string fields
-	if !plan.NoteURL.IsNull() {
-		v := plan.NoteURL.ValueString()
-		updateReq.NoteURL = &v
-	}
-	if !plan.Note.IsNull() {
-		v := plan.Note.ValueString()
-		updateReq.Note = &v
-	}
-	if !plan.ActionURL.IsNull() {
-		v := plan.ActionURL.ValueString()
-		updateReq.ActionURL = &v
-	}
-	if !plan.IconAlternative.IsNull() {
-		v := plan.IconAlternative.ValueString()
-		updateReq.IconAlternative = &v
-	}
-	if !plan.Comment.IsNull() {
-		v := plan.Comment.ValueString()
-		updateReq.Comment = &v
-	}
-	if !plan.GeoCoords.IsNull() {
-		v := plan.GeoCoords.ValueString()
-		updateReq.GeoCoords = &v
-	}
-	if !plan.IsActivated.IsNull() {
-		v := plan.IsActivated.ValueBool()
-		updateReq.IsActivated = &v
+	// Only send the fields that changed, cleared fields are sent as null
+	patch, diags := hostPatch(ctx, state, plan, configMacros)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Handle macros - always include them in the update to ensure
-	// they are properly updated per the OpenAPI documentation
-	if len(plan.Macros) > 0 {
-		logging.Info(ctx, "Including macros in update", map[string]interface{}{
-			"host":   plan.Name.ValueString(),
-			"macros": len(plan.Macros),
-		})
-
-		updateReq.Macros = make([]client.HostMacro, len(plan.Macros))
-		for i, m := range plan.Macros {
-			macro := client.HostMacro{
-				Name:       m.Name.ValueString(),
-				IsPassword: m.IsPassword.ValueBool(),
-			}
-
-			// Always include the value when updating
-			if !m.Value.IsNull() {
-				v := m.Value.ValueString()
-				macro.Value = &v
-			}
+	logging.Info(ctx, "Updating host", map[string]interface{}{
+		"host":   state.Name.ValueString(),
+		"fields": patch.Fields(),
+	})
 
-			if !m.Description.IsNull() {
-				v := m.Description.ValueString()
-				macro.Description = &v
-			}
+	// Call API to update host, unless only Terraform-side attributes changed
+	if len(patch) > 0 {
+		if err := r.client.UpdateHost(state.Name.ValueString(), patch); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating host",
+				fmt.Sprintf("Could not update host %s: %v", state.Name.ValueString(), err),
+			)
+			return
+		}
+	}
 
-			updateReq.Macros[i] = macro
+	// States saved before the id attribute was added don't hold the ID yet
+	if plan.ID.IsNull() || plan.ID.IsUnknown() {
+		hostID, err := r.client.FindHostIDByName(plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading host",
+				fmt.Sprintf("Could not read host %s: %v", plan.Name.ValueString(), err),
+			)
+			return
 		}
+		plan.ID = types.Int64Value(int64(hostID))
 	}
 
-	// Call API to update host
-	if err := r.client.UpdateHost(updateReq); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating host",
-			fmt.Sprintf("Could not update host %s: %v", plan.Name.ValueString(), err),
-		)
+	resp.Diagnostics.Append(r.updateHostRelations(ctx, int(plan.ID.ValueInt64()), state, plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -1045,6 +1892,7 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Update state with plan
+	resp.Diagnostics.Append(r.waitForStatus(ctx, int(plan.ID.ValueInt64()), plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"terraform-provider-centreon/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMacrosEqual(t *testing.T) {
+	port := macroModel{
+		Value:       types.StringValue("3306"),
+		IsPassword:  types.BoolValue(false),
+		Description: types.StringValue("MySQL port"),
+	}
+	password := macroModel{
+		ValueWOVersion: types.Int64Value(1),
+		IsPassword:     types.BoolValue(true),
+	}
+
+	tests := []struct {
+		name string
+		a, b map[string]macroModel
+		want bool
+	}{
+		{
+			name: "both empty",
+			want: true,
+		},
+		{
+			name: "same macros",
+			a:    map[string]macroModel{"PORT": port, "PASSWORD": password},
+			b:    map[string]macroModel{"PASSWORD": password, "PORT": port},
+			want: true,
+		},
+		{
+			name: "added macro",
+			a:    map[string]macroModel{"PORT": port},
+			b:    map[string]macroModel{"PORT": port, "PASSWORD": password},
+		},
+		{
+			name: "renamed macro",
+			a:    map[string]macroModel{"PORT": port},
+			b:    map[string]macroModel{"MYSQL_PORT": port},
+		},
+		{
+			name: "changed value",
+			a:    map[string]macroModel{"PORT": port},
+			b: map[string]macroModel{"PORT": {
+				Value:       types.StringValue("3307"),
+				IsPassword:  port.IsPassword,
+				Description: port.Description,
+			}},
+		},
+		{
+			name: "changed write-only version",
+			a:    map[string]macroModel{"PASSWORD": password},
+			b: map[string]macroModel{"PASSWORD": {
+				ValueWOVersion: types.Int64Value(2),
+				IsPassword:     password.IsPassword,
+			}},
+		},
+		{
+			name: "write-only value is ignored",
+			a:    map[string]macroModel{"PASSWORD": password},
+			b: map[string]macroModel{"PASSWORD": {
+				ValueWO:        types.StringValue("secret"),
+				ValueWOVersion: password.ValueWOVersion,
+				IsPassword:     password.IsPassword,
+			}},
+			want: true,
+		},
+		{
+			name: "changed description",
+			a:    map[string]macroModel{"PORT": port},
+			b: map[string]macroModel{"PORT": {
+				Value:      port.Value,
+				IsPassword: port.IsPassword,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := macrosEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("macrosEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostValidateConfigMacros(t *testing.T) {
+	ctx := context.Background()
+	r := &hostResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	macrosType := objectType.AttributeTypes["macros"].(tftypes.Map)
+	macroType := macrosType.ElementType.(tftypes.Object)
+
+	tests := []struct {
+		name    string
+		macro   map[string]interface{}
+		wantErr bool
+	}{
+		{name: "value", macro: map[string]interface{}{"value": "3306"}},
+		{name: "write-only password", macro: map[string]interface{}{"value_wo": "secret", "value_wo_version": 1, "is_password": true}},
+		{name: "password value", macro: map[string]interface{}{"value": "secret", "is_password": true}, wantErr: true},
+		{name: "both values", macro: map[string]interface{}{"value": "3306", "value_wo": "3306", "value_wo_version": 1}, wantErr: true},
+		{name: "write-only value without version", macro: map[string]interface{}{"value_wo": "secret"}, wantErr: true},
+		{name: "version without write-only value", macro: map[string]interface{}{"value_wo_version": 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			macro := make(map[string]tftypes.Value, len(macroType.AttributeTypes))
+			for name, attributeType := range macroType.AttributeTypes {
+				macro[name] = tftypes.NewValue(attributeType, tt.macro[name])
+			}
+			attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			attributes["macros"] = tftypes.NewValue(macrosType, map[string]tftypes.Value{
+				"MACRO": tftypes.NewValue(macroType, macro),
+			})
+
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, attributes),
+			}}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestHostPatch(t *testing.T) {
+	ctx := context.Background()
+
+	state := hostResourceModel{
+		Name:               types.StringValue("db01"),
+		Address:            types.StringValue("10.0.0.1"),
+		Alias:              types.StringValue("Database"),
+		MaxCheckAttempts:   types.Int64Value(3),
+		ActiveCheckEnabled: types.BoolValue(true),
+		CheckCommandArgs:   []types.String{types.StringValue("-w 80")},
+		IsActivated:        types.BoolValue(true),
+		Categories:         setFromInts([]int{1}),
+		Groups:             setFromInts([]int{2}),
+		Templates:          listFromInts([]int{3, 4}),
+		Macros: map[string]macroModel{
+			"PORT": {Value: types.StringValue("3306"), IsPassword: types.BoolValue(false)},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		update       func(plan *hostResourceModel)
+		configMacros map[string]macroModel
+		want         client.HostPatch
+	}{
+		{
+			name:   "unchanged",
+			update: func(plan *hostResourceModel) {},
+			want:   client.HostPatch{},
+		},
+		{
+			name: "changed fields",
+			update: func(plan *hostResourceModel) {
+				plan.Address = types.StringValue("10.0.0.2")
+				plan.MaxCheckAttempts = types.Int64Value(5)
+				plan.IsActivated = types.BoolValue(false)
+			},
+			want: client.HostPatch{
+				"address":            "10.0.0.2",
+				"max_check_attempts": int64(5),
+				"is_activated":       false,
+			},
+		},
+		{
+			name: "cleared fields",
+			update: func(plan *hostResourceModel) {
+				plan.Alias = types.StringNull()
+				plan.MaxCheckAttempts = types.Int64Null()
+				plan.ActiveCheckEnabled = types.BoolNull()
+				plan.CheckCommandArgs = nil
+			},
+			want: client.HostPatch{
+				"alias":                nil,
+				"max_check_attempts":   nil,
+				"active_check_enabled": triStateInherit,
+				"check_command_args":   []string{},
+			},
+		},
+		{
+			name: "cleared links",
+			update: func(plan *hostResourceModel) {
+				plan.Categories = setFromInts(nil)
+				plan.Groups = setFromInts(nil)
+			},
+			want: client.HostPatch{
+				"categories": []int{},
+				"groups":     []int{},
+			},
+		},
+		{
+			name: "reordered templates",
+			update: func(plan *hostResourceModel) {
+				plan.Templates = listFromInts([]int{4, 3})
+			},
+			want: client.HostPatch{
+				"templates": []int{4, 3},
+			},
+		},
+		{
+			name: "changed write-only macro",
+			update: func(plan *hostResourceModel) {
+				plan.Macros = map[string]macroModel{
+					"PORT": state.Macros["PORT"],
+					"PASSWORD": {
+						ValueWOVersion: types.Int64Value(1),
+						IsPassword:     types.BoolValue(true),
+					},
+				}
+			},
+			configMacros: map[string]macroModel{
+				"PASSWORD": {ValueWO: types.StringValue("secret")},
+			},
+			want: client.HostPatch{
+				"macros": []client.HostMacro{
+					{Name: "PASSWORD", Value: stringPointer("secret"), IsPassword: true},
+					{Name: "PORT", Value: stringPointer("3306")},
+				},
+			},
+		},
+		{
+			name: "removed macros",
+			update: func(plan *hostResourceModel) {
+				plan.Macros = nil
+			},
+			want: client.HostPatch{
+				"macros": []client.HostMacro{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			tt.update(&plan)
+
+			got, diags := hostPatch(ctx, state, plan, tt.configMacros)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hostPatch() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+// hostParentsServer returns a client of a fake API serving the parents of
+// hosts, and a pointer to the number of parent lists read.
+func hostParentsServer(t *testing.T, parents func(hostID int) []int) (*client.Client, *int) {
+	t.Helper()
+	reads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var hostID int
+		if _, err := fmt.Sscanf(req.URL.Path, "/configuration/hosts/%d/parents", &hostID); err != nil {
+			http.NotFound(w, req)
+			return
+		}
+		reads++
+
+		type host struct {
+			ID int `json:"id"`
+		}
+		result := []host{}
+		for _, id := range parents(hostID) {
+			result = append(result, host{ID: id})
+		}
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"result": result}); err != nil {
+			t.Errorf("could not encode the parents of host %d: %v", hostID, err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.NewClient("http", "localhost", "80", "latest", "key")
+	c.BaseURL = server.URL
+	return c, &reads
+}
+
+func TestCheckHostRelations(t *testing.T) {
+	// Host 1 has host 2 as parent, which has host 3 as parent
+	hierarchy := map[int][]int{1: {2}, 2: {3}}
+	c, _ := hostParentsServer(t, func(hostID int) []int { return hierarchy[hostID] })
+	r := &hostResource{client: c}
+
+	ids := func(values ...int) types.Set { return setFromInts(values) }
+	unknownID := types.Int64Unknown()
+
+	tests := []struct {
+		name        string
+		id          types.Int64
+		parents     types.Set
+		children    types.Set
+		wantErr     bool
+		wantWarning bool
+	}{
+		{name: "new host", id: unknownID, parents: ids(1), children: ids(4)},
+		{name: "unmanaged relationships", id: types.Int64Value(4), parents: types.SetNull(types.Int64Type), children: types.SetNull(types.Int64Type)},
+		{name: "unknown parents", id: unknownID, parents: types.SetUnknown(types.Int64Type), children: ids(3)},
+		{name: "own parent", id: types.Int64Value(4), parents: ids(4), children: ids(), wantErr: true},
+		{name: "own child", id: types.Int64Value(4), parents: ids(), children: ids(4), wantErr: true},
+		{name: "parent and child", id: unknownID, parents: ids(5), children: ids(5), wantErr: true},
+		{name: "host already an ancestor", id: types.Int64Value(3), parents: ids(1), children: ids(), wantErr: true},
+		{name: "child already an ancestor", id: unknownID, parents: ids(1), children: ids(2), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := r.checkHostRelations(tt.id, tt.parents, tt.children)
+			if got := diags.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("warning = %v, want %v: %v", got, tt.wantWarning, diags)
+			}
+		})
+	}
+}
+
+func TestFindAncestor(t *testing.T) {
+	// Hosts 1 and 2 share the ancestors 3 and 4, hosts from 100 have a
+	// longer chain of ancestors than the limit
+	hierarchy := map[int][]int{1: {3}, 2: {3}, 3: {4}}
+	c, reads := hostParentsServer(t, func(hostID int) []int {
+		if hostID >= 100 {
+			return []int{hostID + 1}
+		}
+		return hierarchy[hostID]
+	})
+	r := &hostResource{client: c}
+
+	hostParents := make(map[int][]int)
+	targets := map[int64]bool{5: true}
+	for _, hostID := range []int{1, 2} {
+		if _, found, err := r.findAncestor(hostID, targets, hostParents); found || err != nil {
+			t.Errorf("findAncestor(%d) = %v, %v, want not found", hostID, found, err)
+		}
+	}
+	if *reads != 4 {
+		t.Errorf("read the parents of %d hosts, want 4 as the shared ancestors are cached", *reads)
+	}
+
+	ancestor, found, err := r.findAncestor(1, map[int64]bool{4: true}, hostParents)
+	if err != nil || !found || ancestor != 4 {
+		t.Errorf("findAncestor(1) = %d, %v, %v, want ancestor 4", ancestor, found, err)
+	}
+	ancestor, found, err = r.findAncestor(2, map[int64]bool{2: true}, hostParents)
+	if err != nil || !found || ancestor != 2 {
+		t.Errorf("findAncestor(2) = %d, %v, %v, want the host itself", ancestor, found, err)
+	}
+
+	if _, _, err := r.findAncestor(100, targets, make(map[int][]int)); err == nil {
+		t.Errorf("findAncestor(100) walked more than %d ancestors", maxHostAncestors)
+	}
+
+	// Too many ancestors only warns, as the relationships may be valid
+	diags := r.checkHostRelations(types.Int64Value(1), setFromInts([]int{100}), setFromInts(nil))
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("checkHostRelations() = %v, want a single warning", diags)
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// hostStateUpgrades lists the steps migrating the raw JSON state of a host
+// to the next schema version, indexed by the version they upgrade from.
+// Prior states go through every step up to the current version.
+var hostStateUpgrades = []func(state map[string]interface{}) error{
+	upgradeHostStateV0,
+	upgradeHostStateV1,
+	upgradeHostStateV2,
+}
+
+func (r *hostResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(hostStateUpgrades))
+	for version := range hostStateUpgrades {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				upgradeHostState(version, req, resp)
+			},
+		}
+	}
+	return upgraders
+}
+
+func upgradeHostState(version int, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Host State",
+			fmt.Sprintf("Could not decode the host state of schema version %d: %v", version, err),
+		)
+		return
+	}
+
+	for from := version; from < len(hostStateUpgrades); from++ {
+		if err := hostStateUpgrades[from](state); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Host State",
+				fmt.Sprintf("Could not upgrade the host state from schema version %d: %v", from, err),
+			)
+			return
+		}
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Host State",
+			fmt.Sprintf("Could not encode the upgraded host state: %v", err),
+		)
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
+
+// upgradeHostStateV0 turns the groups and categories lists into sets. Lists
+// and sets share the same JSON representation, only duplicates are dropped.
+func upgradeHostStateV0(state map[string]interface{}) error {
+	for _, name := range []string{"groups", "group_names", "categories", "category_names"} {
+		values, ok := state[name].([]interface{})
+		if !ok {
+			continue
+		}
+
+		seen := make(map[string]bool, len(values))
+		unique := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			key := fmt.Sprint(value)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			unique = append(unique, value)
+		}
+		state[name] = unique
+	}
+	return nil
+}
+
+// upgradeHostStateV1 turns the macros list into a map keyed by macro name.
+func upgradeHostStateV1(state map[string]interface{}) error {
+	list, ok := state["macros"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	macros := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		macro, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected macro value: %v", item)
+		}
+		name, ok := macro["name"].(string)
+		if !ok {
+			return fmt.Errorf("macro without a name: %v", item)
+		}
+		macros[name] = map[string]interface{}{
+			"value":            macro["value"],
+			"is_password":      macro["is_password"],
+			"description":      macro["description"],
+			"value_wo":         nil,
+			"value_wo_version": nil,
+		}
+	}
+	state["macros"] = macros
+	return nil
+}
+
+// upgradeHostStateV2 turns the 0/1/2 enabled flags into optional booleans,
+// 2 (inherited from the templates) becoming null. 0 was the default of the
+// flags, stored for hosts whose configuration never set them, so it becomes
+// null as well rather than false, which would show a diff to null on the
+// first plan. Flags explicitly disabled are set to false again on that plan.
+func upgradeHostStateV2(state map[string]interface{}) error {
+	for _, name := range []string{
+		"active_check_enabled",
+		"passive_check_enabled",
+		"notification_enabled",
+		"freshness_checked",
+		"flap_detection_enabled",
+		"event_handler_enabled",
+	} {
+		switch value := state[name].(type) {
+		case nil:
+		case float64:
+			if value == triStateDisabled {
+				state[name] = nil
+				continue
+			}
+			state[name] = boolFromTriState(int(value)).ValueBoolPointer()
+		default:
+			return fmt.Errorf("unexpected %s value: %v", name, value)
+		}
+	}
+	return nil
+}
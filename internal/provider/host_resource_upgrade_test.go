@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func decodeState(t *testing.T, raw string) map[string]interface{} {
+	t.Helper()
+	var state map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		t.Fatalf("invalid state JSON: %v", err)
+	}
+	return state
+}
+
+func TestUpgradeHostStateSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		upgrade func(map[string]interface{}) error
+		state   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "v0 drops duplicate groups and categories",
+			upgrade: upgradeHostStateV0,
+			state:   `{"groups": [1, 2, 1], "group_names": ["a", "a"], "categories": [3], "templates": [4, 4]}`,
+			want:    `{"groups": [1, 2], "group_names": ["a"], "categories": [3], "templates": [4, 4]}`,
+		},
+		{
+			name:    "v0 keeps null sets",
+			upgrade: upgradeHostStateV0,
+			state:   `{"groups": null, "categories": null}`,
+			want:    `{"groups": null, "categories": null}`,
+		},
+		{
+			name:    "v1 keys macros by name",
+			upgrade: upgradeHostStateV1,
+			state:   `{"macros": [{"name": "PORT", "value": "3306", "is_password": false, "description": null}]}`,
+			want: `{"macros": {"PORT": {"value": "3306", "is_password": false, "description": null,
+				"value_wo": null, "value_wo_version": null}}}`,
+		},
+		{
+			name:    "v1 keeps null macros",
+			upgrade: upgradeHostStateV1,
+			state:   `{"macros": null}`,
+			want:    `{"macros": null}`,
+		},
+		{
+			name:    "v1 rejects macros without a name",
+			upgrade: upgradeHostStateV1,
+			state:   `{"macros": [{"value": "3306"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "v2 converts enabled flags",
+			upgrade: upgradeHostStateV2,
+			state:   `{"passive_check_enabled": 1, "notification_enabled": 2, "freshness_checked": null}`,
+			want:    `{"passive_check_enabled": true, "notification_enabled": null, "freshness_checked": null}`,
+		},
+		{
+			name:    "v2 turns default flags never configured into null",
+			upgrade: upgradeHostStateV2,
+			state:   `{"active_check_enabled": 0, "flap_detection_enabled": 0, "event_handler_enabled": 1}`,
+			want:    `{"active_check_enabled": null, "flap_detection_enabled": null, "event_handler_enabled": true}`,
+		},
+		{
+			name:    "v2 rejects unexpected flags",
+			upgrade: upgradeHostStateV2,
+			state:   `{"active_check_enabled": "yes"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := decodeState(t, tt.state)
+			err := tt.upgrade(state)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got state %v", state)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Compare the JSON representations, as the upgrades may store
+			// pointers
+			encoded, err := json.Marshal(state)
+			if err != nil {
+				t.Fatalf("invalid upgraded state: %v", err)
+			}
+			if got, want := decodeState(t, string(encoded)), decodeState(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUpgradeHostStateFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &hostResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	v0 := `{
+		"name": "db01",
+		"address": "10.0.0.1",
+		"monitoring_server_id": 1,
+		"groups": [2, 2, 3],
+		"categories": [4],
+		"templates": [5],
+		"active_check_enabled": 1,
+		"passive_check_enabled": 2,
+		"notification_enabled": 0,
+		"macros": [{"name": "PORT", "value": "3306", "is_password": false, "description": "MySQL port"}]
+	}`
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("no upgrader from schema version 0")
+	}
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(v0)}}
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// The upgraded state must decode with the current schema
+	upgraded, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("upgraded state doesn't match the current schema: %v", err)
+	}
+
+	var state map[string]interface{}
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &state); err != nil {
+		t.Fatalf("invalid upgraded state: %v", err)
+	}
+	want := map[string]interface{}{
+		"name":                  "db01",
+		"address":               "10.0.0.1",
+		"monitoring_server_id":  float64(1),
+		"groups":                []interface{}{float64(2), float64(3)},
+		"categories":            []interface{}{float64(4)},
+		"templates":             []interface{}{float64(5)},
+		"active_check_enabled":  true,
+		"passive_check_enabled": nil,
+		"notification_enabled":  nil,
+		"macros": map[string]interface{}{
+			"PORT": map[string]interface{}{
+				"value":            "3306",
+				"value_wo":         nil,
+				"value_wo_version": nil,
+				"is_password":      false,
+				"description":      "MySQL port",
+			},
+		},
+	}
+	if !reflect.DeepEqual(state, want) {
+		t.Errorf("got %v, want %v", state, want)
+	}
+	if upgraded.IsNull() {
+		t.Error("upgraded state is null")
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &hostSeveritiesDataSource{}
+
+func NewHostSeveritiesDataSource() datasource.DataSource {
+	return &hostSeveritiesDataSource{}
+}
+
+type hostSeveritiesDataSource struct {
+	client *client.Client
+}
+
+type hostSeverityDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	Level       types.Int64  `tfsdk:"level"`
+	IconID      types.Int64  `tfsdk:"icon_id"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+type hostSeveritiesDataSourceModel struct {
+	Limit      types.Int64          `tfsdk:"limit"`
+	Page       types.Int64          `tfsdk:"page"`
+	Search     *searchModel         `tfsdk:"search"`
+	Severities []hostSeverityDetail `tfsdk:"severities"`
+	Id         types.String         `tfsdk:"id"`
+}
+
+func (d *hostSeveritiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_severities"
+}
+
+func (d *hostSeveritiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of host severities.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"severities": schema.ListNestedAttribute{
+				Description: "List of host severities",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Severity ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Severity name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Severity alias",
+							Computed:    true,
+						},
+						"level": schema.Int64Attribute{
+							Description: "Severity level",
+							Computed:    true,
+						},
+						"icon_id": schema.Int64Attribute{
+							Description: "Severity icon ID",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the severity is activated",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Comment",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *hostSeveritiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *hostSeveritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hostSeveritiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severitiesResponse, err := d.client.GetHostSeverities(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Host Severities",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Severities = make([]hostSeverityDetail, len(severitiesResponse.Result))
+	for i, severity := range severitiesResponse.Result {
+		state.Severities[i] = hostSeverityDetail{
+			ID:          types.Int64Value(int64(severity.ID)),
+			Name:        types.StringValue(severity.Name),
+			Alias:       types.StringValue(severity.Alias),
+			Level:       types.Int64Value(int64(severity.Level)),
+			IconID:      types.Int64Value(int64(severity.IconID)),
+			IsActivated: types.BoolValue(severity.IsActivated),
+			Comment:     stringFromAPI(severity.Comment, types.StringNull()),
+		}
+	}
+
+	state.Id = types.StringValue("host_severities")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &hostSeverityResource{}
+	_ resource.ResourceWithImportState = &hostSeverityResource{}
+)
+
+func NewHostSeverityResource() resource.Resource {
+	return &hostSeverityResource{}
+}
+
+type hostSeverityResource struct {
+	client *client.Client
+}
+
+type hostSeverityResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	Level       types.Int64  `tfsdk:"level"`
+	IconID      types.Int64  `tfsdk:"icon_id"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+	Comment     types.String `tfsdk:"comment"`
+}
+
+func (r *hostSeverityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_severity"
+}
+
+func (r *hostSeverityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon host severity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Host severity ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Host severity name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Host severity alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"level": schema.Int64Attribute{
+				Required:    true,
+				Description: "Severity level, lower levels being more severe",
+				Validators: []validator.Int64{
+					validation.Int64BetweenValidator{Min: -128, Max: 127},
+				},
+			},
+			"icon_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the icon displayed for the severity",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the host severity is activated",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *hostSeverityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m hostSeverityResourceModel) request() *client.HostSeverityRequest {
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	return &client.HostSeverityRequest{
+		Name:        m.Name.ValueString(),
+		Alias:       alias,
+		Level:       int(m.Level.ValueInt64()),
+		IconID:      int(m.IconID.ValueInt64()),
+		IsActivated: m.IsActivated.ValueBool(),
+		Comment:     optionalString(m.Comment),
+	}
+}
+
+// refresh updates the model with the host severity returned by the API.
+func (m *hostSeverityResourceModel) refresh(severity *client.HostSeverity) {
+	m.ID = types.Int64Value(int64(severity.ID))
+	m.Name = types.StringValue(severity.Name)
+	m.Alias = types.StringValue(severity.Alias)
+	m.Level = types.Int64Value(int64(severity.Level))
+	m.IconID = types.Int64Value(int64(severity.IconID))
+	m.IsActivated = types.BoolValue(severity.IsActivated)
+	m.Comment = stringFromAPI(severity.Comment, m.Comment)
+}
+
+func (r *hostSeverityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hostSeverityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating host severity", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	severity, err := r.client.CreateHostSeverity(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating host severity",
+			fmt.Sprintf("Could not create host severity: %v", err),
+		)
+		return
+	}
+	plan.refresh(severity)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating host severity",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostSeverityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hostSeverityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severity, err := r.client.GetHostSeverity(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host severity",
+			fmt.Sprintf("Could not read host severity %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(severity)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *hostSeverityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hostSeverityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateHostSeverity(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating host severity",
+			fmt.Sprintf("Could not update host severity %d: %v", id, err),
+		)
+		return
+	}
+
+	severity, err := r.client.GetHostSeverity(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading host severity",
+			fmt.Sprintf("Could not read host severity %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(severity)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating host severity",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *hostSeverityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hostSeverityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteHostSeverity(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting host severity",
+			fmt.Sprintf("Could not delete host severity %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting host severity",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *hostSeverityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
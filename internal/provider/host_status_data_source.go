@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &hostStatusDataSource{}
+
+func NewHostStatusDataSource() datasource.DataSource {
+	return &hostStatusDataSource{}
+}
+
+type hostStatusDataSource struct {
+	client *client.Client
+}
+
+type hostStatusDataSourceModel struct {
+	HostID          types.Int64  `tfsdk:"host_id"`
+	Name            types.String `tfsdk:"name"`
+	State           types.String `tfsdk:"state"`
+	StateCode       types.Int64  `tfsdk:"state_code"`
+	Output          types.String `tfsdk:"output"`
+	LastCheck       types.String `tfsdk:"last_check"`
+	LastStateChange types.String `tfsdk:"last_state_change"`
+	Acknowledged    types.Bool   `tfsdk:"acknowledged"`
+	InDowntime      types.Bool   `tfsdk:"in_downtime"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (d *hostStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_status"
+}
+
+func (d *hostStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the real-time status of a host from the monitoring.",
+		Attributes: map[string]schema.Attribute{
+			"host_id": schema.Int64Attribute{
+				Description: "Host ID",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Host name",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Current state: UP, DOWN, UNREACHABLE or PENDING",
+				Computed:    true,
+			},
+			"state_code": schema.Int64Attribute{
+				Description: "Current state code: 0 for UP, 1 for DOWN, 2 for UNREACHABLE and 4 for PENDING",
+				Computed:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "Output of the last check",
+				Computed:    true,
+			},
+			"last_check": schema.StringAttribute{
+				Description: "Time of the last check, in RFC 3339 format. Null until the host is checked",
+				Computed:    true,
+			},
+			"last_state_change": schema.StringAttribute{
+				Description: "Time of the last state change, in RFC 3339 format",
+				Computed:    true,
+			},
+			"acknowledged": schema.BoolAttribute{
+				Description: "Whether the host problem is acknowledged",
+				Computed:    true,
+			},
+			"in_downtime": schema.BoolAttribute{
+				Description: "Whether the host is in downtime",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *hostStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *hostStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hostStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostState, err := d.client.GetHostState(int(state.HostID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Host Status",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(hostState.Name)
+	state.State = types.StringValue(hostState.Status.Name)
+	state.StateCode = types.Int64Value(int64(hostState.Status.Code))
+	state.Output = types.StringValue(hostState.Information)
+	state.LastCheck = timeValue(hostState.LastCheck)
+	state.LastStateChange = timeValue(hostState.LastStatusChange)
+	state.Acknowledged = types.BoolValue(hostState.IsAcknowledged)
+	state.InDowntime = types.BoolValue(hostState.IsInDowntime)
+	state.Id = types.StringValue(fmt.Sprintf("%d", hostState.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,500 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &metaServiceResource{}
+	_ resource.ResourceWithImportState      = &metaServiceResource{}
+	_ resource.ResourceWithConfigValidators = &metaServiceResource{}
+)
+
+func NewMetaServiceResource() resource.Resource {
+	return &metaServiceResource{}
+}
+
+type metaServiceResource struct {
+	client *client.Client
+}
+
+type metaServiceResourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Output                   types.String `tfsdk:"output"`
+	CalculationType          types.String `tfsdk:"calculation_type"`
+	DataSourceType           types.String `tfsdk:"data_source_type"`
+	Regexp                   types.String `tfsdk:"regexp"`
+	Metric                   types.String `tfsdk:"metric"`
+	Metrics                  types.Set    `tfsdk:"metrics"`
+	Warning                  types.String `tfsdk:"warning"`
+	Critical                 types.String `tfsdk:"critical"`
+	CheckTimeperiodID        types.Int64  `tfsdk:"check_timeperiod_id"`
+	MaxCheckAttempts         types.Int64  `tfsdk:"max_check_attempts"`
+	NormalCheckInterval      types.Int64  `tfsdk:"normal_check_interval"`
+	RetryCheckInterval       types.Int64  `tfsdk:"retry_check_interval"`
+	NotificationEnabled      types.Bool   `tfsdk:"notification_enabled"`
+	NotificationTypes        types.Set    `tfsdk:"notification_types"`
+	NotificationInterval     types.Int64  `tfsdk:"notification_interval"`
+	NotificationTimeperiodID types.Int64  `tfsdk:"notification_timeperiod_id"`
+	ContactIDs               types.Set    `tfsdk:"contact_ids"`
+	ContactGroupIDs          types.Set    `tfsdk:"contact_group_ids"`
+	IsActivated              types.Bool   `tfsdk:"is_activated"`
+	Comment                  types.String `tfsdk:"comment"`
+}
+
+// metaServiceMetricModel is a service metric aggregated by a meta service.
+type metaServiceMetricModel struct {
+	HostID    types.Int64  `tfsdk:"host_id"`
+	ServiceID types.Int64  `tfsdk:"service_id"`
+	Metric    types.String `tfsdk:"metric"`
+}
+
+var metaServiceMetricType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host_id":    types.Int64Type,
+		"service_id": types.Int64Type,
+		"metric":     types.StringType,
+	},
+}
+
+func (r *metaServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meta_service"
+}
+
+func (r *metaServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon meta service, a virtual service computing an aggregate of a metric of several services. " +
+			"Metrics are selected either with regexp and metric, or with metrics.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Meta service ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Meta service name",
+			},
+			"output": schema.StringAttribute{
+				Optional:    true,
+				Description: "Output format of the meta service, where %d is replaced by the computed value",
+			},
+			"calculation_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Function aggregating the metrics: average, min, max or sum",
+				Validators: []validator.String{
+					validation.OneOfValidator{Values: validation.MetaServiceCalculationTypes},
+				},
+			},
+			"data_source_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("gauge"),
+				Description: "Data source type of the computed metric: gauge, counter, derive or absolute. Defaults to gauge",
+				Validators: []validator.String{
+					validation.OneOfValidator{Values: validation.DataSourceTypes},
+				},
+			},
+			"regexp": schema.StringAttribute{
+				Optional:    true,
+				Description: "SQL LIKE pattern matching the descriptions of the aggregated services, such as %CPU%. Requires metric, conflicts with metrics",
+			},
+			"metric": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the metric aggregated from the services matching regexp",
+			},
+			"metrics": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Aggregated service metrics. Conflicts with regexp",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "ID of the host of the service",
+						},
+						"service_id": schema.Int64Attribute{
+							Required:    true,
+							Description: "Service ID",
+						},
+						"metric": schema.StringAttribute{
+							Required:    true,
+							Description: "Metric name",
+						},
+					},
+				},
+			},
+			"warning": schema.StringAttribute{
+				Optional:    true,
+				Description: "Warning threshold of the computed value",
+			},
+			"critical": schema.StringAttribute{
+				Optional:    true,
+				Description: "Critical threshold of the computed value",
+			},
+			"check_timeperiod_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Check timeperiod ID",
+			},
+			"max_check_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of checks before a hard state",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
+			},
+			"normal_check_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Interval between checks, in minutes",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
+			},
+			"retry_check_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Interval between checks in a soft state, in minutes",
+				Validators: []validator.Int64{
+					validation.Int64AtLeastValidator{Min: 1},
+				},
+			},
+			"notification_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether notifications are enabled",
+			},
+			"notification_types": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Notification types, any of: warning, unknown, critical, recovery, flapping, downtime_scheduled",
+				Validators: []validator.Set{
+					validation.NotificationTypesValidator{Types: validation.ServiceNotificationTypes},
+				},
+			},
+			"notification_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Interval between notifications, in minutes",
+			},
+			"notification_timeperiod_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Notification timeperiod ID",
+			},
+			"contact_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the notified contacts",
+			},
+			"contact_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the notified contact groups",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the meta service is activated",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *metaServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *metaServiceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validation.ExactlyOneOfValidator{
+			Paths: []path.Path{path.Root("regexp"), path.Root("metrics")},
+		},
+		validation.RequiresAttributeValidator{
+			Attribute: path.Root("regexp"),
+			Required:  path.Root("metric"),
+		},
+		validation.RequiresAttributeValidator{
+			Attribute: path.Root("metric"),
+			Required:  path.Root("regexp"),
+		},
+	}
+}
+
+// request builds the API payload from the plan.
+func (m metaServiceResourceModel) request(ctx context.Context) (*client.MetaServiceRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	metaServiceReq := &client.MetaServiceRequest{
+		Name:                     m.Name.ValueString(),
+		Output:                   optionalString(m.Output),
+		CalculationType:          m.CalculationType.ValueString(),
+		DataSourceType:           m.DataSourceType.ValueString(),
+		SelectionMode:            client.MetaServiceSelectionRegexp,
+		RegexpString:             optionalString(m.Regexp),
+		Metric:                   optionalString(m.Metric),
+		Metrics:                  []client.MetaServiceMetric{},
+		Warning:                  optionalString(m.Warning),
+		Critical:                 optionalString(m.Critical),
+		CheckTimeperiodID:        optionalInt64(m.CheckTimeperiodID),
+		MaxCheckAttempts:         optionalInt64(m.MaxCheckAttempts),
+		NormalCheckInterval:      optionalInt64(m.NormalCheckInterval),
+		RetryCheckInterval:       optionalInt64(m.RetryCheckInterval),
+		NotificationInterval:     optionalInt64(m.NotificationInterval),
+		NotificationTimeperiodID: optionalInt64(m.NotificationTimeperiodID),
+		IsActivated:              m.IsActivated.ValueBool(),
+		Comment:                  optionalString(m.Comment),
+	}
+
+	if !m.Metrics.IsNull() && !m.Metrics.IsUnknown() {
+		var metrics []metaServiceMetricModel
+		diags.Append(m.Metrics.ElementsAs(ctx, &metrics, false)...)
+		for _, metric := range metrics {
+			metaServiceReq.Metrics = append(metaServiceReq.Metrics, client.MetaServiceMetric{
+				HostID:    int(metric.HostID.ValueInt64()),
+				ServiceID: int(metric.ServiceID.ValueInt64()),
+				Metric:    metric.Metric.ValueString(),
+			})
+		}
+		metaServiceReq.SelectionMode = client.MetaServiceSelectionList
+	}
+
+	if !m.NotificationEnabled.IsNull() && !m.NotificationEnabled.IsUnknown() {
+		v := m.NotificationEnabled.ValueBool()
+		metaServiceReq.IsNotificationEnabled = &v
+	}
+
+	if !m.NotificationTypes.IsNull() && !m.NotificationTypes.IsUnknown() {
+		var names []string
+		diags.Append(m.NotificationTypes.ElementsAs(ctx, &names, false)...)
+		mask, err := validation.NotificationTypesToBitmask(names, validation.ServiceNotificationTypes)
+		if err != nil {
+			diags.AddAttributeError(path.Root("notification_types"), "Invalid Notification Types", err.Error())
+		}
+		v := int(mask)
+		metaServiceReq.NotificationOptions = &v
+	}
+
+	contacts, d := intsFromSet(ctx, m.ContactIDs)
+	diags.Append(d...)
+	contactGroups, d := intsFromSet(ctx, m.ContactGroupIDs)
+	diags.Append(d...)
+	metaServiceReq.Contacts = nonNilInts(contacts)
+	metaServiceReq.ContactGroups = nonNilInts(contactGroups)
+
+	return metaServiceReq, diags
+}
+
+// refresh updates the model with the meta service returned by the API.
+func (m *metaServiceResourceModel) refresh(metaService *client.MetaService) {
+	m.ID = types.Int64Value(int64(metaService.ID))
+	m.Name = types.StringValue(metaService.Name)
+	m.Output = stringFromAPI(metaService.Output, m.Output)
+	m.CalculationType = types.StringValue(metaService.CalculationType)
+	m.DataSourceType = types.StringValue(metaService.DataSourceType)
+	m.Warning = stringFromAPI(metaService.Warning, m.Warning)
+	m.Critical = stringFromAPI(metaService.Critical, m.Critical)
+
+	m.Regexp = types.StringNull()
+	m.Metric = types.StringNull()
+	m.Metrics = types.SetNull(metaServiceMetricType)
+	if metaService.SelectionMode == client.MetaServiceSelectionList {
+		elements := make([]attr.Value, len(metaService.Metrics))
+		for i, metric := range metaService.Metrics {
+			elements[i] = types.ObjectValueMust(metaServiceMetricType.AttrTypes, map[string]attr.Value{
+				"host_id":    types.Int64Value(int64(metric.HostID)),
+				"service_id": types.Int64Value(int64(metric.ServiceID)),
+				"metric":     types.StringValue(metric.Metric),
+			})
+		}
+		m.Metrics = types.SetValueMust(metaServiceMetricType, elements)
+	} else {
+		m.Regexp = stringFromAPI(metaService.RegexpString, m.Regexp)
+		m.Metric = stringFromAPI(metaService.Metric, m.Metric)
+	}
+
+	m.CheckTimeperiodID = int64FromAPI(metaService.CheckTimeperiodID)
+	m.MaxCheckAttempts = int64FromAPI(metaService.MaxCheckAttempts)
+	m.NormalCheckInterval = int64FromAPI(metaService.NormalCheckInterval)
+	m.RetryCheckInterval = int64FromAPI(metaService.RetryCheckInterval)
+	m.NotificationEnabled = types.BoolNull()
+	if metaService.IsNotificationEnabled != nil {
+		m.NotificationEnabled = types.BoolValue(*metaService.IsNotificationEnabled)
+	}
+	m.NotificationTypes = types.SetNull(types.StringType)
+	if metaService.NotificationOptions != nil {
+		m.NotificationTypes = setFromStrings(validation.BitmaskToNotificationTypes(
+			int64(*metaService.NotificationOptions), validation.ServiceNotificationTypes))
+	}
+	m.NotificationInterval = int64FromAPI(metaService.NotificationInterval)
+	m.NotificationTimeperiodID = int64FromAPI(metaService.NotificationTimeperiodID)
+	m.ContactIDs = optionalSetFromInts(metaService.Contacts, m.ContactIDs)
+	m.ContactGroupIDs = optionalSetFromInts(metaService.ContactGroups, m.ContactGroupIDs)
+	m.IsActivated = types.BoolValue(metaService.IsActivated)
+	m.Comment = stringFromAPI(metaService.Comment, m.Comment)
+}
+
+func (r *metaServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan metaServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaServiceReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating meta service", map[string]interface{}{
+		"name": metaServiceReq.Name,
+	})
+
+	metaService, err := r.client.CreateMetaService(metaServiceReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating meta service",
+			fmt.Sprintf("Could not create meta service: %v", err),
+		)
+		return
+	}
+	plan.refresh(metaService)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating meta service",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *metaServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state metaServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaService, err := r.client.GetMetaService(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading meta service",
+			fmt.Sprintf("Could not read meta service %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(metaService)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *metaServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan metaServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaServiceReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateMetaService(id, metaServiceReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating meta service",
+			fmt.Sprintf("Could not update meta service %d: %v", id, err),
+		)
+		return
+	}
+
+	metaService, err := r.client.GetMetaService(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading meta service",
+			fmt.Sprintf("Could not read meta service %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(metaService)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating meta service",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *metaServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state metaServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteMetaService(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting meta service",
+			fmt.Sprintf("Could not delete meta service %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting meta service",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *metaServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
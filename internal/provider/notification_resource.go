@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &notificationResource{}
+	_ resource.ResourceWithImportState    = &notificationResource{}
+	_ resource.ResourceWithValidateConfig = &notificationResource{}
+)
+
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+type notificationResource struct {
+	client *client.Client
+}
+
+type notificationResourceModel struct {
+	ID                 types.Int64                     `tfsdk:"id"`
+	Name               types.String                    `tfsdk:"name"`
+	TimeperiodID       types.Int64                     `tfsdk:"timeperiod_id"`
+	IsActivated        types.Bool                      `tfsdk:"is_activated"`
+	UserIDs            types.Set                       `tfsdk:"user_ids"`
+	ContactGroupIDs    types.Set                       `tfsdk:"contact_group_ids"`
+	HostGroups         *notificationHostGroupsModel    `tfsdk:"host_groups"`
+	ServiceGroups      *notificationResourceGroupModel `tfsdk:"service_groups"`
+	BusinessActivities *notificationResourceGroupModel `tfsdk:"business_activities"`
+	Channels           types.Set                       `tfsdk:"channels"`
+	Subject            types.String                    `tfsdk:"subject"`
+	Message            types.String                    `tfsdk:"message"`
+}
+
+// notificationHostGroupsModel selects host groups, notified on host events
+// and optionally on the events of their services.
+type notificationHostGroupsModel struct {
+	IDs           types.Set `tfsdk:"ids"`
+	Events        types.Set `tfsdk:"events"`
+	ServiceEvents types.Set `tfsdk:"service_events"`
+}
+
+// notificationResourceGroupModel selects service groups or business
+// activities, notified on service events.
+type notificationResourceGroupModel struct {
+	IDs    types.Set `tfsdk:"ids"`
+	Events types.Set `tfsdk:"events"`
+}
+
+func (r *notificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+// eventsAttribute returns the schema of a set of notification rule events.
+func eventsAttribute(required bool, description string, events []validation.NotificationType) schema.SetAttribute {
+	return schema.SetAttribute{
+		Required:    required,
+		Optional:    !required,
+		ElementType: types.StringType,
+		Description: description,
+		Validators: []validator.Set{
+			validation.SetSizeAtLeastValidator{Min: 1},
+			validation.NotificationTypesValidator{Types: events},
+		},
+	}
+}
+
+func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon notification rule, notifying users and contact groups of the events of host groups, " +
+			"service groups and business activities through channels, with a message template. " +
+			"Notification rules are only available on recent Centreon versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Notification rule ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Notification rule name",
+			},
+			"timeperiod_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the time period during which notifications are sent",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the notification rule is enabled",
+			},
+			"user_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the notified users",
+			},
+			"contact_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the notified contact groups",
+			},
+			"host_groups": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Host groups whose events are notified",
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.Int64Type,
+						Description: "IDs of the host groups",
+					},
+					"events":         eventsAttribute(true, "Notified host events: up, down or unreachable", validation.NotificationRuleHostEvents),
+					"service_events": eventsAttribute(false, "Notified events of the services of the hosts: ok, warning, critical or unknown", validation.NotificationRuleServiceEvents),
+				},
+			},
+			"service_groups": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Service groups whose events are notified",
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.Int64Type,
+						Description: "IDs of the service groups",
+					},
+					"events": eventsAttribute(true, "Notified service events: ok, warning, critical or unknown", validation.NotificationRuleServiceEvents),
+				},
+			},
+			"business_activities": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Business activities whose events are notified. Requires the Centreon BAM module",
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.Int64Type,
+						Description: "IDs of the business activities",
+					},
+					"events": eventsAttribute(true, "Notified business activity events: ok, warning, critical or unknown", validation.NotificationRuleServiceEvents),
+				},
+			},
+			"channels": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Channels the notifications are sent through: email, slack or sms",
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.NotificationChannels},
+				},
+			},
+			"subject": schema.StringAttribute{
+				Required:    true,
+				Description: "Subject of the notifications, sent on every channel",
+			},
+			"message": schema.StringAttribute{
+				Required:    true,
+				Description: "Message template of the notifications, sent on every channel",
+			},
+		},
+	}
+}
+
+func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the rule notifies someone of some resources.
+// Unknown values may be set once known and are not reported as missing.
+func (r *notificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var hostGroups, serviceGroups, businessActivities types.Object
+	var userIDs, contactGroupIDs types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("host_groups"), &hostGroups)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("service_groups"), &serviceGroups)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("business_activities"), &businessActivities)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_ids"), &userIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("contact_group_ids"), &contactGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if hostGroups.IsNull() && serviceGroups.IsNull() && businessActivities.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host_groups"),
+			"Missing Attribute",
+			"At least one of host_groups, service_groups or business_activities must be set.",
+		)
+	}
+	if userIDs.IsNull() && contactGroupIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_ids"),
+			"Missing Attribute",
+			"At least one of user_ids or contact_group_ids must be set.",
+		)
+	}
+}
+
+// eventsMask converts a set of event names to the bitmask expected by the API.
+func eventsMask(ctx context.Context, set types.Set, events []validation.NotificationType, p path.Path) (int, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return 0, nil
+	}
+
+	var names []string
+	diags := set.ElementsAs(ctx, &names, false)
+	mask, err := validation.NotificationTypesToBitmask(names, events)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Events", err.Error())
+	}
+	return int(mask), diags
+}
+
+// eventsFromMask converts an events bitmask returned by the API to a set of
+// names. No events are kept null when the set is unset.
+func eventsFromMask(mask int, current types.Set, events []validation.NotificationType) types.Set {
+	if mask == 0 && current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return setFromStrings(validation.BitmaskToNotificationTypes(int64(mask), events))
+}
+
+// request builds the API payload from the plan, sending the same message on
+// every channel.
+func (m notificationResourceModel) request(ctx context.Context) (*client.NotificationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users, d := intsFromSet(ctx, m.UserIDs)
+	diags.Append(d...)
+	contactGroups, d := intsFromSet(ctx, m.ContactGroupIDs)
+	diags.Append(d...)
+
+	resources := []client.NotificationResource{}
+	if m.HostGroups != nil {
+		ids, d := intsFromSet(ctx, m.HostGroups.IDs)
+		diags.Append(d...)
+		events, d := eventsMask(ctx, m.HostGroups.Events, validation.NotificationRuleHostEvents, path.Root("host_groups").AtName("events"))
+		diags.Append(d...)
+		serviceEvents, d := eventsMask(ctx, m.HostGroups.ServiceEvents, validation.NotificationRuleServiceEvents, path.Root("host_groups").AtName("service_events"))
+		diags.Append(d...)
+		resources = append(resources, client.NotificationResource{
+			Type:   client.NotificationResourceHostGroup,
+			Events: events,
+			IDs:    nonNilInts(ids),
+			Extra:  &client.NotificationResourceExtra{EventServices: serviceEvents},
+		})
+	}
+	for _, group := range []struct {
+		resourceType string
+		model        *notificationResourceGroupModel
+		path         path.Path
+	}{
+		{client.NotificationResourceServiceGroup, m.ServiceGroups, path.Root("service_groups")},
+		{client.NotificationResourceBusinessActivity, m.BusinessActivities, path.Root("business_activities")},
+	} {
+		if group.model == nil {
+			continue
+		}
+		ids, d := intsFromSet(ctx, group.model.IDs)
+		diags.Append(d...)
+		events, d := eventsMask(ctx, group.model.Events, validation.NotificationRuleServiceEvents, group.path.AtName("events"))
+		diags.Append(d...)
+		resources = append(resources, client.NotificationResource{
+			Type:   group.resourceType,
+			Events: events,
+			IDs:    nonNilInts(ids),
+		})
+	}
+
+	var channels []string
+	diags.Append(m.Channels.ElementsAs(ctx, &channels, false)...)
+	messages := []client.NotificationMessage{}
+	for _, channel := range channels {
+		code, err := validation.FailureCriteriaToCodes([]string{channel}, validation.NotificationChannels)
+		if err != nil {
+			diags.AddAttributeError(path.Root("channels"), "Invalid Channel", err.Error())
+			continue
+		}
+		messages = append(messages, client.NotificationMessage{
+			Channel: code,
+			Subject: m.Subject.ValueString(),
+			Message: m.Message.ValueString(),
+		})
+	}
+
+	return &client.NotificationRequest{
+		Name:          m.Name.ValueString(),
+		TimePeriodID:  int(m.TimeperiodID.ValueInt64()),
+		IsActivated:   m.IsActivated.ValueBool(),
+		Users:         nonNilInts(users),
+		ContactGroups: nonNilInts(contactGroups),
+		Resources:     resources,
+		Messages:      messages,
+	}, diags
+}
+
+// refresh updates the model with the notification rule returned by the API.
+// The subject and message are read from the first channel.
+func (m *notificationResourceModel) refresh(notification *client.Notification) {
+	m.ID = types.Int64Value(int64(notification.ID))
+	m.Name = types.StringValue(notification.Name)
+	m.TimeperiodID = types.Int64Value(int64(notification.TimePeriodID))
+	m.IsActivated = types.BoolValue(notification.IsActivated)
+	m.UserIDs = optionalSetFromInts(notification.Users, m.UserIDs)
+	m.ContactGroupIDs = optionalSetFromInts(notification.ContactGroups, m.ContactGroupIDs)
+
+	hostGroups := m.HostGroups
+	m.HostGroups, m.ServiceGroups, m.BusinessActivities = nil, nil, nil
+	for _, res := range notification.Resources {
+		switch res.Type {
+		case client.NotificationResourceHostGroup:
+			currentServiceEvents := types.SetNull(types.StringType)
+			if hostGroups != nil {
+				currentServiceEvents = hostGroups.ServiceEvents
+			}
+			serviceEvents := 0
+			if res.Extra != nil {
+				serviceEvents = res.Extra.EventServices
+			}
+			m.HostGroups = &notificationHostGroupsModel{
+				IDs:           setFromInts(res.IDs),
+				Events:        eventsFromMask(res.Events, types.SetNull(types.StringType), validation.NotificationRuleHostEvents),
+				ServiceEvents: eventsFromMask(serviceEvents, currentServiceEvents, validation.NotificationRuleServiceEvents),
+			}
+		case client.NotificationResourceServiceGroup:
+			m.ServiceGroups = notificationResourceGroup(res)
+		case client.NotificationResourceBusinessActivity:
+			m.BusinessActivities = notificationResourceGroup(res)
+		}
+	}
+
+	channels := make([]string, 0, len(notification.Messages))
+	for i, message := range notification.Messages {
+		channels = append(channels, validation.CodesToFailureCriteria(message.Channel, validation.NotificationChannels)...)
+		if i == 0 {
+			m.Subject = types.StringValue(message.Subject)
+			m.Message = types.StringValue(message.Message)
+		}
+	}
+	m.Channels = setFromStrings(channels)
+}
+
+// notificationResourceGroup converts service group or business activity
+// resources returned by the API.
+func notificationResourceGroup(res client.NotificationResource) *notificationResourceGroupModel {
+	return &notificationResourceGroupModel{
+		IDs:    setFromInts(res.IDs),
+		Events: eventsFromMask(res.Events, types.SetNull(types.StringType), validation.NotificationRuleServiceEvents),
+	}
+}
+
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating notification rule", map[string]interface{}{
+		"name": notificationReq.Name,
+	})
+
+	notification, err := r.client.CreateNotification(notificationReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating notification rule",
+			fmt.Sprintf("Could not create notification rule: %v", err),
+		)
+		return
+	}
+	plan.refresh(notification)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification rule",
+			fmt.Sprintf("Could not read notification rule %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(notification)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateNotification(id, notificationReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating notification rule",
+			fmt.Sprintf("Could not update notification rule %d: %v", id, err),
+		)
+		return
+	}
+
+	notification, err := r.client.GetNotification(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification rule",
+			fmt.Sprintf("Could not read notification rule %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(notification)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteNotification(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting notification rule",
+			fmt.Sprintf("Could not delete notification rule %d: %v", id, err),
+		)
+		return
+	}
+}
+
+func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
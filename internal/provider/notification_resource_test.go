@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-centreon/internal/validation"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEventsMask(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		set     types.Set
+		want    int
+		wantErr bool
+	}{
+		{name: "null", set: types.SetNull(types.StringType), want: 0},
+		{name: "unknown", set: types.SetUnknown(types.StringType), want: 0},
+		{name: "one event", set: setFromStrings([]string{"down"}), want: 2},
+		{name: "every event", set: setFromStrings([]string{"up", "down", "unreachable"}), want: 7},
+		{name: "service event", set: setFromStrings([]string{"critical"}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := eventsMask(ctx, tt.set, validation.NotificationRuleHostEvents, path.Root("events"))
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("eventsMask() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventsFromMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		mask    int
+		current types.Set
+		want    types.Set
+	}{
+		{
+			name:    "no events keep an unset set null",
+			current: types.SetNull(types.StringType),
+			want:    types.SetNull(types.StringType),
+		},
+		{
+			name:    "no events empty a set",
+			current: setFromStrings([]string{"ok"}),
+			want:    setFromStrings(nil),
+		},
+		{
+			name:    "events",
+			mask:    10,
+			current: types.SetNull(types.StringType),
+			want:    setFromStrings([]string{"warning", "unknown"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := eventsFromMask(tt.mask, tt.current, validation.NotificationRuleServiceEvents)
+			if !got.Equal(tt.want) {
+				t.Errorf("eventsFromMask(%d) = %v, want %v", tt.mask, got, tt.want)
+			}
+		})
+	}
+
+	// Every combination of events survives a round trip
+	ctx := context.Background()
+	for mask := 1; mask < 16; mask++ {
+		set := eventsFromMask(mask, types.SetNull(types.StringType), validation.NotificationRuleServiceEvents)
+		back, diags := eventsMask(ctx, set, validation.NotificationRuleServiceEvents, path.Root("events"))
+		if diags.HasError() || back != mask {
+			t.Errorf("round trip of %d = %d, %v", mask, back, diags)
+		}
+	}
+}
+
+func TestNotificationValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &notificationResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	hostGroupsType := objectType.AttributeTypes["host_groups"]
+	idsType := objectType.AttributeTypes["user_ids"]
+
+	hostGroups := tftypes.NewValue(hostGroupsType, map[string]tftypes.Value{
+		"ids":            tftypes.NewValue(idsType, []tftypes.Value{tftypes.NewValue(tftypes.Number, 1)}),
+		"events":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "down")}),
+		"service_events": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+	})
+	userIDs := tftypes.NewValue(idsType, []tftypes.Value{tftypes.NewValue(tftypes.Number, 2)})
+
+	tests := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		{
+			name:   "host groups and users",
+			values: map[string]tftypes.Value{"host_groups": hostGroups, "user_ids": userIDs},
+		},
+		{
+			name: "unknown resources and users",
+			values: map[string]tftypes.Value{
+				"host_groups": tftypes.NewValue(hostGroupsType, tftypes.UnknownValue),
+				"user_ids":    tftypes.NewValue(idsType, tftypes.UnknownValue),
+			},
+		},
+		{
+			name:    "no resources",
+			values:  map[string]tftypes.Value{"user_ids": userIDs},
+			wantErr: true,
+		},
+		{
+			name:    "no one notified",
+			values:  map[string]tftypes.Value{"host_groups": hostGroups},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attributeType := range objectType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			for name, value := range tt.values {
+				attributes[name] = value
+			}
+
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, attributes),
+			}}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -41,6 +41,7 @@ type centreonProviderModel struct {
 	APIVersion                     types.String `tfsdk:"api_version"`
 	APIKey                         types.String `tfsdk:"api_key"`
 	GenerateAndReloadConfiguration types.Bool   `tfsdk:"generate_and_reload_configuration"`
+	ValidateReferences             types.Bool   `tfsdk:"validate_references"`
 }
 
 // Metadata returns the provider type name.
@@ -78,6 +79,10 @@ func (p *centreonProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				Optional:    true,
 				Description: "When true, automatically generates and reloads the configuration for all monitoring servers after applying changes",
 			},
+			"validate_references": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, checks at plan time that the IDs referenced by resources (templates, groups, monitoring servers, commands) exist. Lookups are cached for the rest of the run",
+			},
 		},
 	}
 }
@@ -128,6 +133,7 @@ func (p *centreonProvider) Configure(ctx context.Context, req provider.Configure
 		config.APIKey.ValueString(),
 	)
 	client.GenerateAndReloadConfiguration = !config.GenerateAndReloadConfiguration.IsNull() && config.GenerateAndReloadConfiguration.ValueBool()
+	client.ValidateReferences = !config.ValidateReferences.IsNull() && config.ValidateReferences.ValueBool()
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -141,6 +147,16 @@ func (p *centreonProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewMonitoringServersDataSource,
 		NewHostGroupsDataSource,
 		NewHostTemplatesDataSource,
+		NewHostCategoriesDataSource,
+		NewHostSeveritiesDataSource,
+		NewServiceGroupsDataSource,
+		NewServiceCategoriesDataSource,
+		NewServiceSeveritiesDataSource,
+		NewEscalationsDataSource,
+		NewHostStatusDataSource,
+		NewServiceStatusDataSource,
+		NewConnectorsDataSource,
+		NewResourceAccessRulesDataSource,
 	}
 }
 
@@ -148,5 +164,28 @@ func (p *centreonProvider) DataSources(_ context.Context) []func() datasource.Da
 func (p *centreonProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewHostResource,
+		NewHostCategoryResource,
+		NewHostSeverityResource,
+		NewServiceGroupResource,
+		NewServiceCategoryResource,
+		NewServiceSeverityResource,
+		NewHostDependencyResource,
+		NewServiceDependencyResource,
+		NewEscalationResource,
+		NewRecurringDowntimeResource,
+		NewDowntimeResource,
+		NewAcknowledgementResource,
+		NewMetaServiceResource,
+		NewResourceMacroResource,
+		NewVendorResource,
+		NewSNMPTrapResource,
+		NewConnectorResource,
+		NewUserResource,
+		NewAPITokenResource,
+		NewResourceAccessRuleResource,
+		NewACLGroupResource,
+		NewACLResourceAccessResource,
+		NewACLActionAccessResource,
+		NewNotificationResource,
 	}
 }
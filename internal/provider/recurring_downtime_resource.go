@@ -0,0 +1,452 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &recurringDowntimeResource{}
+	_ resource.ResourceWithImportState = &recurringDowntimeResource{}
+)
+
+func NewRecurringDowntimeResource() resource.Resource {
+	return &recurringDowntimeResource{}
+}
+
+type recurringDowntimeResource struct {
+	client *client.Client
+}
+
+type recurringDowntimeResourceModel struct {
+	ID              types.Int64           `tfsdk:"id"`
+	Name            types.String          `tfsdk:"name"`
+	Description     types.String          `tfsdk:"description"`
+	IsActivated     types.Bool            `tfsdk:"is_activated"`
+	Periods         []downtimePeriodModel `tfsdk:"periods"`
+	HostIDs         types.Set             `tfsdk:"host_ids"`
+	HostGroupIDs    types.Set             `tfsdk:"host_group_ids"`
+	Services        types.Set             `tfsdk:"services"`
+	ServiceGroupIDs types.Set             `tfsdk:"service_group_ids"`
+}
+
+type downtimePeriodModel struct {
+	StartTime  types.String `tfsdk:"start_time"`
+	EndTime    types.String `tfsdk:"end_time"`
+	Fixed      types.Bool   `tfsdk:"fixed"`
+	Duration   types.Int64  `tfsdk:"duration"`
+	Weekdays   types.Set    `tfsdk:"weekdays"`
+	MonthDays  types.Set    `tfsdk:"month_days"`
+	NthWeekday types.String `tfsdk:"nth_weekday"`
+}
+
+func (r *recurringDowntimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recurring_downtime"
+}
+
+func (r *recurringDowntimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon recurring downtime, such as a monthly patch window.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Recurring downtime ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Recurring downtime name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Recurring downtime description",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the recurring downtime is activated",
+			},
+			"periods": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Periods of the downtime. Each period sets exactly one of weekdays, month_days or nth_weekday",
+				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						validation.DowntimePeriodValidator{},
+					},
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Required:    true,
+							Description: "Start time, in HH:MM format",
+							Validators: []validator.String{
+								validation.TimeOfDayValidator{},
+							},
+						},
+						"end_time": schema.StringAttribute{
+							Required:    true,
+							Description: "End time, in HH:MM format. 24:00 ends the downtime at midnight",
+							Validators: []validator.String{
+								validation.TimeOfDayValidator{},
+							},
+						},
+						"fixed": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether the downtime lasts from start_time to end_time. Flexible downtimes start with the first problem within the period and last for duration",
+						},
+						"duration": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Duration of flexible downtimes, in seconds. Required when fixed is false",
+							Validators: []validator.Int64{
+								validation.Int64AtLeastValidator{Min: 1},
+							},
+						},
+						"weekdays": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Days of every week, from monday to sunday",
+							Validators: []validator.Set{
+								validation.WeekdaysValidator{},
+							},
+						},
+						"month_days": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Description: "Days of every month, from 1 to 31",
+						},
+						"nth_weekday": schema.StringAttribute{
+							Optional:    true,
+							Description: "Occurrence of a weekday in every month, such as \"first monday\" or \"last friday\"",
+							Validators: []validator.String{
+								validation.NthWeekdayValidator{},
+							},
+						},
+					},
+				},
+			},
+			"host_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the hosts in downtime",
+			},
+			"host_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the host groups in downtime",
+			},
+			"services": schema.SetNestedAttribute{
+				Optional:     true,
+				Description:  "Services in downtime",
+				NestedObject: serviceReferenceSchema(),
+			},
+			"service_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the service groups in downtime",
+			},
+		},
+	}
+}
+
+func (r *recurringDowntimeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request converts the period to the API payload.
+func (p downtimePeriodModel) request(ctx context.Context) (client.DowntimePeriod, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	period := client.DowntimePeriod{
+		StartTime:   p.StartTime.ValueString(),
+		EndTime:     p.EndTime.ValueString(),
+		Fixed:       p.Fixed.IsNull() || p.Fixed.ValueBool(),
+		Duration:    optionalInt64(p.Duration),
+		DaysOfWeek:  []int{},
+		DaysOfMonth: []int{},
+	}
+
+	switch {
+	case !p.Weekdays.IsNull():
+		var days []string
+		diags.Append(p.Weekdays.ElementsAs(ctx, &days, false)...)
+		for _, day := range days {
+			number, err := validation.WeekdayNumber(day)
+			if err != nil {
+				diags.AddError("Invalid Downtime Period", err.Error())
+				continue
+			}
+			period.DaysOfWeek = append(period.DaysOfWeek, number)
+		}
+		sort.Ints(period.DaysOfWeek)
+		period.MonthCycle = "all"
+	case !p.MonthDays.IsNull():
+		days, d := intsFromSet(ctx, p.MonthDays)
+		diags.Append(d...)
+		sort.Ints(days)
+		period.DaysOfMonth = nonNilInts(days)
+		period.MonthCycle = "none"
+	default:
+		cycle, day, err := validation.ParseNthWeekday(p.NthWeekday.ValueString())
+		if err != nil {
+			diags.AddError("Invalid Downtime Period", err.Error())
+			break
+		}
+		number, _ := validation.WeekdayNumber(day)
+		period.DaysOfWeek = []int{number}
+		period.MonthCycle = cycle
+	}
+
+	return period, diags
+}
+
+// downtimePeriodFromAPI converts a period returned by the API. The current
+// period, if any, keeps the duration of fixed periods null when unset.
+func downtimePeriodFromAPI(period client.DowntimePeriod, current *downtimePeriodModel) downtimePeriodModel {
+	model := downtimePeriodModel{
+		StartTime:  types.StringValue(period.StartTime),
+		EndTime:    types.StringValue(period.EndTime),
+		Fixed:      types.BoolValue(period.Fixed),
+		Duration:   int64FromAPI(period.Duration),
+		Weekdays:   types.SetNull(types.StringType),
+		MonthDays:  types.SetNull(types.Int64Type),
+		NthWeekday: types.StringNull(),
+	}
+	if period.Fixed && (current == nil || current.Duration.IsNull()) {
+		model.Duration = types.Int64Null()
+	}
+
+	var days []string
+	for _, number := range period.DaysOfWeek {
+		if day, err := validation.WeekdayName(number); err == nil {
+			days = append(days, day)
+		}
+	}
+
+	switch period.MonthCycle {
+	case "all":
+		model.Weekdays = setFromStrings(days)
+	case "none":
+		model.MonthDays = setFromInts(period.DaysOfMonth)
+	default:
+		if len(days) > 0 {
+			model.NthWeekday = types.StringValue(period.MonthCycle + " " + days[0])
+		}
+	}
+
+	return model
+}
+
+// request builds the API payload from the plan.
+func (m recurringDowntimeResourceModel) request(ctx context.Context) (*client.RecurringDowntimeRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	periods := make([]client.DowntimePeriod, len(m.Periods))
+	for i, p := range m.Periods {
+		period, d := p.request(ctx)
+		diags.Append(d...)
+		periods[i] = period
+	}
+	hosts, d := intsFromSet(ctx, m.HostIDs)
+	diags.Append(d...)
+	hostGroups, d := intsFromSet(ctx, m.HostGroupIDs)
+	diags.Append(d...)
+	services, d := serviceReferencesFromSet(ctx, m.Services)
+	diags.Append(d...)
+	serviceGroups, d := intsFromSet(ctx, m.ServiceGroupIDs)
+	diags.Append(d...)
+
+	return &client.RecurringDowntimeRequest{
+		Name:          m.Name.ValueString(),
+		Description:   optionalString(m.Description),
+		IsActivated:   m.IsActivated.ValueBool(),
+		Periods:       periods,
+		Hosts:         nonNilInts(hosts),
+		HostGroups:    nonNilInts(hostGroups),
+		Services:      services,
+		ServiceGroups: nonNilInts(serviceGroups),
+	}, diags
+}
+
+// refresh updates the model with the recurring downtime returned by the API.
+func (m *recurringDowntimeResourceModel) refresh(downtime *client.RecurringDowntime) {
+	periods := make([]downtimePeriodModel, len(downtime.Periods))
+	for i, period := range downtime.Periods {
+		var current *downtimePeriodModel
+		if i < len(m.Periods) {
+			current = &m.Periods[i]
+		}
+		periods[i] = downtimePeriodFromAPI(period, current)
+	}
+
+	m.ID = types.Int64Value(int64(downtime.ID))
+	m.Name = types.StringValue(downtime.Name)
+	m.Description = stringFromAPI(downtime.Description, m.Description)
+	m.IsActivated = types.BoolValue(downtime.IsActivated)
+	m.Periods = periods
+	m.HostIDs = optionalSetFromInts(downtime.Hosts, m.HostIDs)
+	m.HostGroupIDs = optionalSetFromInts(downtime.HostGroups, m.HostGroupIDs)
+	m.Services = optionalSetFromServiceReferences(downtime.Services, m.Services)
+	m.ServiceGroupIDs = optionalSetFromInts(downtime.ServiceGroups, m.ServiceGroupIDs)
+}
+
+func (r *recurringDowntimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recurringDowntimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtimeReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating recurring downtime", map[string]interface{}{
+		"name": downtimeReq.Name,
+	})
+
+	downtime, err := r.client.CreateRecurringDowntime(downtimeReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating recurring downtime",
+			fmt.Sprintf("Could not create recurring downtime: %v", err),
+		)
+		return
+	}
+	plan.refresh(downtime)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating recurring downtime",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *recurringDowntimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state recurringDowntimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtime, err := r.client.GetRecurringDowntime(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading recurring downtime",
+			fmt.Sprintf("Could not read recurring downtime %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(downtime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *recurringDowntimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan recurringDowntimeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downtimeReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateRecurringDowntime(id, downtimeReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating recurring downtime",
+			fmt.Sprintf("Could not update recurring downtime %d: %v", id, err),
+		)
+		return
+	}
+
+	downtime, err := r.client.GetRecurringDowntime(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading recurring downtime",
+			fmt.Sprintf("Could not read recurring downtime %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(downtime)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating recurring downtime",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *recurringDowntimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state recurringDowntimeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteRecurringDowntime(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting recurring downtime",
+			fmt.Sprintf("Could not delete recurring downtime %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting recurring downtime",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *recurringDowntimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
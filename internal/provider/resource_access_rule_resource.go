@@ -0,0 +1,452 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &resourceAccessRuleResource{}
+	_ resource.ResourceWithImportState    = &resourceAccessRuleResource{}
+	_ resource.ResourceWithValidateConfig = &resourceAccessRuleResource{}
+)
+
+func NewResourceAccessRuleResource() resource.Resource {
+	return &resourceAccessRuleResource{}
+}
+
+type resourceAccessRuleResource struct {
+	client *client.Client
+}
+
+type resourceAccessRuleResourceModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	IsEnabled        types.Bool   `tfsdk:"is_enabled"`
+	ContactIDs       types.Set    `tfsdk:"contact_ids"`
+	AllContacts      types.Bool   `tfsdk:"all_contacts"`
+	ContactGroupIDs  types.Set    `tfsdk:"contact_group_ids"`
+	AllContactGroups types.Bool   `tfsdk:"all_contact_groups"`
+	Datasets         types.List   `tfsdk:"datasets"`
+}
+
+// datasetFilterKind maps a dataset filter type to the attributes of a dataset
+// selecting some or all of its resources.
+type datasetFilterKind struct {
+	filterType string
+	ids        string
+	all        string
+	name       string
+}
+
+// datasetFilterKinds are ordered as the API nests dataset filters, from the
+// broadest to the narrowest.
+var datasetFilterKinds = []datasetFilterKind{
+	{client.DatasetFilterMonitoringServer, "monitoring_server_ids", "all_monitoring_servers", "monitoring servers"},
+	{client.DatasetFilterHostGroup, "host_group_ids", "all_host_groups", "host groups"},
+	{client.DatasetFilterHostCategory, "host_category_ids", "all_host_categories", "host categories"},
+	{client.DatasetFilterHost, "host_ids", "all_hosts", "hosts"},
+	{client.DatasetFilterServiceGroup, "service_group_ids", "all_service_groups", "service groups"},
+	{client.DatasetFilterServiceCategory, "service_category_ids", "all_service_categories", "service categories"},
+}
+
+var resourceAccessDatasetType = func() types.ObjectType {
+	attrTypes := map[string]attr.Type{}
+	for _, kind := range datasetFilterKinds {
+		attrTypes[kind.ids] = types.SetType{ElemType: types.Int64Type}
+		attrTypes[kind.all] = types.BoolType
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}()
+
+func (r *resourceAccessRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_access_rule"
+}
+
+func (r *resourceAccessRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	datasetAttributes := map[string]schema.Attribute{}
+	for _, kind := range datasetFilterKinds {
+		datasetAttributes[kind.ids] = schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("IDs of the %s of the dataset", kind.name),
+		}
+		datasetAttributes[kind.all] = schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: fmt.Sprintf("Whether the dataset includes all the %s, including the ones created later", kind.name),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon resource access rule, granting contacts and contact groups access to the resources of its datasets. " +
+			"Within a dataset, each set of resources narrows down the previous one, in the order monitoring servers, host groups, " +
+			"host categories, hosts, service groups and service categories.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Resource access rule ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Resource access rule name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Resource access rule description",
+			},
+			"is_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the rule is enabled",
+			},
+			"contact_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the contacts the rule applies to",
+			},
+			"all_contacts": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the rule applies to all the contacts, including the ones created later",
+			},
+			"contact_group_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the contact groups the rule applies to",
+			},
+			"all_contact_groups": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the rule applies to all the contact groups, including the ones created later",
+			},
+			"datasets": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Datasets of resources the rule grants access to. Each dataset selects some or all resources of at least one type",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: datasetAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAccessRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// validateMembers reports IDs listed along with the matching "all" flag.
+func validateMembers(ids types.Set, all types.Bool, p path.Path, names string, diags *diag.Diagnostics) {
+	if all.ValueBool() && !ids.IsNull() && !ids.IsUnknown() && len(ids.Elements()) > 0 {
+		diags.AddAttributeError(
+			p,
+			"Conflicting Attributes",
+			fmt.Sprintf("%s can't be listed when all of them are included.", names),
+		)
+	}
+}
+
+// ValidateConfig checks that the rule applies to someone, that it has datasets
+// which all select resources, and that IDs aren't listed along with "all"
+// flags.
+func (r *resourceAccessRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceAccessRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateMembers(config.ContactIDs, config.AllContacts, path.Root("contact_ids"), "Contacts", &resp.Diagnostics)
+	validateMembers(config.ContactGroupIDs, config.AllContactGroups, path.Root("contact_group_ids"), "Contact groups", &resp.Diagnostics)
+
+	if !config.ContactIDs.IsUnknown() && !config.ContactGroupIDs.IsUnknown() &&
+		!config.AllContacts.IsUnknown() && !config.AllContactGroups.IsUnknown() &&
+		!config.AllContacts.ValueBool() && !config.AllContactGroups.ValueBool() &&
+		len(config.ContactIDs.Elements()) == 0 && len(config.ContactGroupIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("contact_ids"),
+			"Missing Attribute",
+			"The rule must apply to some contacts or contact groups.",
+		)
+	}
+
+	if config.Datasets.IsNull() || config.Datasets.IsUnknown() {
+		return
+	}
+	if len(config.Datasets.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("datasets"),
+			"Missing Attribute",
+			"The rule must have at least one dataset.",
+		)
+	}
+	for i, element := range config.Datasets.Elements() {
+		dataset, ok := element.(types.Object)
+		if !ok || dataset.IsUnknown() {
+			continue
+		}
+		attrs := dataset.Attributes()
+		selects := false
+		for _, kind := range datasetFilterKinds {
+			ids, _ := attrs[kind.ids].(types.Set)
+			all, _ := attrs[kind.all].(types.Bool)
+			validateMembers(ids, all, path.Root("datasets").AtListIndex(i).AtName(kind.ids), "Resources", &resp.Diagnostics)
+			if ids.IsUnknown() || all.IsUnknown() || all.ValueBool() || (!ids.IsNull() && len(ids.Elements()) > 0) {
+				selects = true
+			}
+		}
+		if !selects {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("datasets").AtListIndex(i),
+				"Invalid Dataset",
+				"A dataset must list some resources or include all the resources of a type.",
+			)
+		}
+	}
+}
+
+// members converts IDs and an "all" flag to the API members.
+func members(ctx context.Context, ids types.Set, all types.Bool) (client.ResourceAccessMembers, diag.Diagnostics) {
+	values, diags := intsFromSet(ctx, ids)
+	if all.ValueBool() {
+		values = nil
+	}
+	return client.ResourceAccessMembers{IDs: nonNilInts(values), All: all.ValueBool()}, diags
+}
+
+// request builds the API payload from the plan, nesting the filters of each
+// dataset in the order of datasetFilterKinds.
+func (m resourceAccessRuleResourceModel) request(ctx context.Context) (*client.ResourceAccessRuleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	contacts, d := members(ctx, m.ContactIDs, m.AllContacts)
+	diags.Append(d...)
+	contactGroups, d := members(ctx, m.ContactGroupIDs, m.AllContactGroups)
+	diags.Append(d...)
+
+	var datasets []types.Object
+	diags.Append(m.Datasets.ElementsAs(ctx, &datasets, false)...)
+
+	filters := []client.ResourceAccessDatasetFilter{}
+	for i, dataset := range datasets {
+		attrs := dataset.Attributes()
+		var root *client.ResourceAccessDatasetFilter
+		next := &root
+		for _, kind := range datasetFilterKinds {
+			ids, d := intsFromSet(ctx, attrs[kind.ids].(types.Set))
+			diags.Append(d...)
+			all := attrs[kind.all].(types.Bool).ValueBool()
+			if len(ids) == 0 && !all {
+				continue
+			}
+			if all {
+				ids = nil
+			}
+			*next = &client.ResourceAccessDatasetFilter{Type: kind.filterType, Resources: nonNilInts(ids)}
+			next = &(*next).DatasetFilter
+		}
+		if root == nil {
+			diags.AddAttributeError(path.Root("datasets").AtListIndex(i), "Invalid Dataset", "The dataset selects no resources.")
+			continue
+		}
+		filters = append(filters, *root)
+	}
+
+	return &client.ResourceAccessRuleRequest{
+		Name:           m.Name.ValueString(),
+		Description:    optionalString(m.Description),
+		IsEnabled:      m.IsEnabled.ValueBool(),
+		Contacts:       contacts,
+		ContactGroups:  contactGroups,
+		DatasetFilters: filters,
+	}, diags
+}
+
+// refresh updates the model with the resource access rule returned by the
+// API. Unset resource IDs are kept as configured, per dataset.
+func (m *resourceAccessRuleResourceModel) refresh(rule *client.ResourceAccessRule) {
+	m.ID = types.Int64Value(int64(rule.ID))
+	m.Name = types.StringValue(rule.Name)
+	m.Description = stringFromAPI(rule.Description, m.Description)
+	m.IsEnabled = types.BoolValue(rule.IsEnabled)
+	m.ContactIDs = optionalSetFromInts(rule.Contacts.IDs, m.ContactIDs)
+	m.AllContacts = types.BoolValue(rule.Contacts.All)
+	m.ContactGroupIDs = optionalSetFromInts(rule.ContactGroups.IDs, m.ContactGroupIDs)
+	m.AllContactGroups = types.BoolValue(rule.ContactGroups.All)
+
+	var current []attr.Value
+	if !m.Datasets.IsNull() && !m.Datasets.IsUnknown() {
+		current = m.Datasets.Elements()
+	}
+
+	elements := make([]attr.Value, len(rule.DatasetFilters))
+	for i := range rule.DatasetFilters {
+		resources := map[string][]int{}
+		for filter := &rule.DatasetFilters[i]; filter != nil; filter = filter.DatasetFilter {
+			resources[filter.Type] = nonNilInts(filter.Resources)
+		}
+
+		var currentAttrs map[string]attr.Value
+		if i < len(current) {
+			if dataset, ok := current[i].(types.Object); ok {
+				currentAttrs = dataset.Attributes()
+			}
+		}
+
+		attrs := map[string]attr.Value{}
+		for _, kind := range datasetFilterKinds {
+			ids, selected := resources[kind.filterType]
+			currentIDs := types.SetNull(types.Int64Type)
+			if set, ok := currentAttrs[kind.ids].(types.Set); ok {
+				currentIDs = set
+			}
+			attrs[kind.ids] = optionalSetFromInts(ids, currentIDs)
+			attrs[kind.all] = types.BoolValue(selected && len(ids) == 0)
+		}
+		elements[i] = types.ObjectValueMust(resourceAccessDatasetType.AttrTypes, attrs)
+	}
+	m.Datasets = types.ListValueMust(resourceAccessDatasetType, elements)
+}
+
+func (r *resourceAccessRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceAccessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating resource access rule", map[string]interface{}{
+		"name": ruleReq.Name,
+	})
+
+	rule, err := r.client.CreateResourceAccessRule(ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating resource access rule",
+			fmt.Sprintf("Could not create resource access rule: %v", err),
+		)
+		return
+	}
+	plan.refresh(rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceAccessRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceAccessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetResourceAccessRule(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading resource access rule",
+			fmt.Sprintf("Could not read resource access rule %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceAccessRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceAccessRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateResourceAccessRule(id, ruleReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating resource access rule",
+			fmt.Sprintf("Could not update resource access rule %d: %v", id, err),
+		)
+		return
+	}
+
+	rule, err := r.client.GetResourceAccessRule(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading resource access rule",
+			fmt.Sprintf("Could not read resource access rule %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceAccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceAccessRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteResourceAccessRule(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting resource access rule",
+			fmt.Sprintf("Could not delete resource access rule %d: %v", id, err),
+		)
+		return
+	}
+}
+
+func (r *resourceAccessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &resourceAccessRulesDataSource{}
+
+func NewResourceAccessRulesDataSource() datasource.DataSource {
+	return &resourceAccessRulesDataSource{}
+}
+
+type resourceAccessRulesDataSource struct {
+	client *client.Client
+}
+
+type resourceAccessRuleDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+}
+
+type resourceAccessRulesDataSourceModel struct {
+	Limit  types.Int64                `tfsdk:"limit"`
+	Page   types.Int64                `tfsdk:"page"`
+	Search *searchModel               `tfsdk:"search"`
+	Rules  []resourceAccessRuleDetail `tfsdk:"rules"`
+	Id     types.String               `tfsdk:"id"`
+}
+
+func (d *resourceAccessRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_access_rules"
+}
+
+func (d *resourceAccessRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of resource access rules.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "List of resource access rules",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Resource access rule ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource access rule name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Resource access rule description",
+							Computed:    true,
+						},
+						"is_enabled": schema.BoolAttribute{
+							Description: "Whether the rule is enabled",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *resourceAccessRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *resourceAccessRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourceAccessRulesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rulesResponse, err := d.client.GetResourceAccessRules(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Resource Access Rules",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Rules = make([]resourceAccessRuleDetail, len(rulesResponse.Result))
+	for i, rule := range rulesResponse.Result {
+		state.Rules[i] = resourceAccessRuleDetail{
+			ID:          types.Int64Value(int64(rule.ID)),
+			Name:        types.StringValue(rule.Name),
+			Description: stringFromAPI(rule.Description, types.StringNull()),
+			IsEnabled:   types.BoolValue(rule.IsEnabled),
+		}
+	}
+
+	state.Id = types.StringValue("resource_access_rules")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
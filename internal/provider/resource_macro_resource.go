@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &resourceMacroResource{}
+	_ resource.ResourceWithImportState = &resourceMacroResource{}
+)
+
+func NewResourceMacroResource() resource.Resource {
+	return &resourceMacroResource{}
+}
+
+type resourceMacroResource struct {
+	client *client.Client
+}
+
+type resourceMacroResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Value               types.String `tfsdk:"value"`
+	IsPassword          types.Bool   `tfsdk:"is_password"`
+	Comment             types.String `tfsdk:"comment"`
+	IsActivated         types.Bool   `tfsdk:"is_activated"`
+	MonitoringServerIDs types.Set    `tfsdk:"monitoring_server_ids"`
+}
+
+func (r *resourceMacroResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_macro"
+}
+
+func (r *resourceMacroResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon resource macro, a global $USERn$-style macro of the monitoring engine, such as the path of the plugins. " +
+			"A macro can be defined with different values for different monitoring servers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Resource macro ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Macro name, enclosed in dollar signs, such as $USER1$",
+				Validators: []validator.String{
+					validation.ResourceMacroNameValidator{},
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Macro value",
+			},
+			"is_password": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the macro value is a password. Password values are masked by Centreon and never read back, so changes made outside Terraform aren't detected",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the resource macro is activated",
+			},
+			"monitoring_server_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the monitoring servers the macro is defined for",
+			},
+		},
+	}
+}
+
+func (r *resourceMacroResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan.
+func (m resourceMacroResourceModel) request(ctx context.Context) (*client.ResourceMacroRequest, diag.Diagnostics) {
+	servers, diags := intsFromSet(ctx, m.MonitoringServerIDs)
+
+	return &client.ResourceMacroRequest{
+		Name:              m.Name.ValueString(),
+		Value:             m.Value.ValueString(),
+		IsPassword:        m.IsPassword.ValueBool(),
+		Comment:           optionalString(m.Comment),
+		IsActivated:       m.IsActivated.ValueBool(),
+		MonitoringServers: nonNilInts(servers),
+	}, diags
+}
+
+// refresh updates the model with the resource macro returned by the API.
+// Masked password values are kept as planned.
+func (m *resourceMacroResourceModel) refresh(macro *client.ResourceMacro) {
+	m.ID = types.Int64Value(int64(macro.ID))
+	m.Name = types.StringValue(macro.Name)
+	if !macro.IsPassword && macro.Value != nil {
+		m.Value = types.StringValue(*macro.Value)
+	}
+	m.IsPassword = types.BoolValue(macro.IsPassword)
+	m.Comment = stringFromAPI(macro.Comment, m.Comment)
+	m.IsActivated = types.BoolValue(macro.IsActivated)
+	m.MonitoringServerIDs = setFromInts(macro.MonitoringServers)
+}
+
+func (r *resourceMacroResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceMacroResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	macroReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating resource macro", map[string]interface{}{
+		"name": macroReq.Name,
+	})
+
+	macro, err := r.client.CreateResourceMacro(macroReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating resource macro",
+			fmt.Sprintf("Could not create resource macro: %v", err),
+		)
+		return
+	}
+	plan.refresh(macro)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating resource macro",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceMacroResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceMacroResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	macro, err := r.client.GetResourceMacro(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading resource macro",
+			fmt.Sprintf("Could not read resource macro %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(macro)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceMacroResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan resourceMacroResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	macroReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateResourceMacro(id, macroReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating resource macro",
+			fmt.Sprintf("Could not update resource macro %d: %v", id, err),
+		)
+		return
+	}
+
+	macro, err := r.client.GetResourceMacro(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading resource macro",
+			fmt.Sprintf("Could not read resource macro %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(macro)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating resource macro",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceMacroResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceMacroResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteResourceMacro(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting resource macro",
+			fmt.Sprintf("Could not delete resource macro %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting resource macro",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceMacroResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serviceCategoriesDataSource{}
+
+func NewServiceCategoriesDataSource() datasource.DataSource {
+	return &serviceCategoriesDataSource{}
+}
+
+type serviceCategoriesDataSource struct {
+	client *client.Client
+}
+
+type serviceCategoryDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+type serviceCategoriesDataSourceModel struct {
+	Limit      types.Int64             `tfsdk:"limit"`
+	Page       types.Int64             `tfsdk:"page"`
+	Search     *searchModel            `tfsdk:"search"`
+	Categories []serviceCategoryDetail `tfsdk:"categories"`
+	Id         types.String            `tfsdk:"id"`
+}
+
+func (d *serviceCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_categories"
+}
+
+func (d *serviceCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of service categories.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "List of service categories",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Category ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Category name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Category alias",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the category is activated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceCategoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceCategoriesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoriesResponse, err := d.client.GetServiceCategories(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Categories",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Categories = make([]serviceCategoryDetail, len(categoriesResponse.Result))
+	for i, category := range categoriesResponse.Result {
+		state.Categories[i] = serviceCategoryDetail{
+			ID:          types.Int64Value(int64(category.ID)),
+			Name:        types.StringValue(category.Name),
+			Alias:       types.StringValue(category.Alias),
+			IsActivated: types.BoolValue(category.IsActivated),
+		}
+	}
+
+	state.Id = types.StringValue("service_categories")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serviceCategoryResource{}
+	_ resource.ResourceWithImportState = &serviceCategoryResource{}
+)
+
+func NewServiceCategoryResource() resource.Resource {
+	return &serviceCategoryResource{}
+}
+
+type serviceCategoryResource struct {
+	client *client.Client
+}
+
+type serviceCategoryResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+func (r *serviceCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_category"
+}
+
+func (r *serviceCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon service category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Service category ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service category name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service category alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the service category is activated",
+			},
+		},
+	}
+}
+
+func (r *serviceCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m serviceCategoryResourceModel) request() *client.ServiceCategoryRequest {
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	return &client.ServiceCategoryRequest{
+		Name:        m.Name.ValueString(),
+		Alias:       alias,
+		IsActivated: m.IsActivated.ValueBool(),
+	}
+}
+
+// refresh updates the model with the service category returned by the API.
+func (m *serviceCategoryResourceModel) refresh(category *client.ServiceCategory) {
+	m.ID = types.Int64Value(int64(category.ID))
+	m.Name = types.StringValue(category.Name)
+	m.Alias = types.StringValue(category.Alias)
+	m.IsActivated = types.BoolValue(category.IsActivated)
+}
+
+func (r *serviceCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating service category", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	category, err := r.client.CreateServiceCategory(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service category",
+			fmt.Sprintf("Could not create service category: %v", err),
+		)
+		return
+	}
+	plan.refresh(category)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating service category",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetServiceCategory(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service category",
+			fmt.Sprintf("Could not read service category %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(category)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateServiceCategory(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service category",
+			fmt.Sprintf("Could not update service category %d: %v", id, err),
+		)
+		return
+	}
+
+	category, err := r.client.GetServiceCategory(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service category",
+			fmt.Sprintf("Could not read service category %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(category)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating service category",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteServiceCategory(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting service category",
+			fmt.Sprintf("Could not delete service category %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting service category",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *serviceCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &serviceDependencyResource{}
+	_ resource.ResourceWithImportState    = &serviceDependencyResource{}
+	_ resource.ResourceWithValidateConfig = &serviceDependencyResource{}
+)
+
+func NewServiceDependencyResource() resource.Resource {
+	return &serviceDependencyResource{}
+}
+
+type serviceDependencyResource struct {
+	client *client.Client
+}
+
+type serviceDependencyResourceModel struct {
+	ID                          types.Int64  `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	ParentServices              types.Set    `tfsdk:"parent_services"`
+	ChildServices               types.Set    `tfsdk:"child_services"`
+	InheritsParent              types.Bool   `tfsdk:"inherits_parent"`
+	ExecutionFailureCriteria    types.Set    `tfsdk:"execution_failure_criteria"`
+	NotificationFailureCriteria types.Set    `tfsdk:"notification_failure_criteria"`
+	Comment                     types.String `tfsdk:"comment"`
+}
+
+func (r *serviceDependencyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_dependency"
+}
+
+func (r *serviceDependencyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	criteria := "One or more of ok, warning, unknown, critical and pending, or none"
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon service dependency. Checks or notifications of the child services are suppressed while a parent service matches the failure criteria.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Service dependency ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service dependency name",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Service dependency description",
+			},
+			"parent_services": schema.SetNestedAttribute{
+				Required:     true,
+				Description:  "Services the child services depend on",
+				NestedObject: serviceReferenceSchema(),
+			},
+			"child_services": schema.SetNestedAttribute{
+				Required:     true,
+				Description:  "Dependent services",
+				NestedObject: serviceReferenceSchema(),
+			},
+			"inherits_parent": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the dependency also applies when the parent services themselves depend on failing services",
+			},
+			"execution_failure_criteria": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Parent service states that suppress checks of the child services. " + criteria,
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.ServiceFailureCriteria},
+				},
+			},
+			"notification_failure_criteria": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Parent service states that suppress notifications of the child services. " + criteria,
+				Validators: []validator.Set{
+					validation.FailureCriteriaValidator{Criteria: validation.ServiceFailureCriteria},
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *serviceDependencyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects services that would depend on themselves.
+func (r *serviceDependencyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var parents, children types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parent_services"), &parents)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("child_services"), &children)...)
+	if resp.Diagnostics.HasError() || parents.IsUnknown() || children.IsUnknown() {
+		return
+	}
+
+	for _, child := range children.Elements() {
+		if value, err := child.ToTerraformValue(ctx); err != nil || !value.IsFullyKnown() {
+			continue
+		}
+		for _, parent := range parents.Elements() {
+			if child.Equal(parent) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("child_services").AtSetValue(child),
+					"Invalid Service Dependency",
+					"A service can't be both a parent and a child of the dependency.",
+				)
+			}
+		}
+	}
+}
+
+// request builds the API payload from the plan.
+func (m serviceDependencyResourceModel) request(ctx context.Context) (*client.ServiceDependencyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parents, d := serviceReferencesFromSet(ctx, m.ParentServices)
+	diags.Append(d...)
+	children, d := serviceReferencesFromSet(ctx, m.ChildServices)
+	diags.Append(d...)
+	execution, d := failureCriteriaCodes(ctx, m.ExecutionFailureCriteria, validation.ServiceFailureCriteria)
+	diags.Append(d...)
+	notification, d := failureCriteriaCodes(ctx, m.NotificationFailureCriteria, validation.ServiceFailureCriteria)
+	diags.Append(d...)
+
+	return &client.ServiceDependencyRequest{
+		Name:                        m.Name.ValueString(),
+		Description:                 optionalString(m.Description),
+		Parents:                     parents,
+		Children:                    children,
+		InheritsParent:              m.InheritsParent.ValueBool(),
+		ExecutionFailureCriteria:    execution,
+		NotificationFailureCriteria: notification,
+		Comment:                     optionalString(m.Comment),
+	}, diags
+}
+
+// refresh updates the model with the service dependency returned by the API.
+func (m *serviceDependencyResourceModel) refresh(dependency *client.ServiceDependency) {
+	m.ID = types.Int64Value(int64(dependency.ID))
+	m.Name = types.StringValue(dependency.Name)
+	m.Description = stringFromAPI(dependency.Description, m.Description)
+	m.ParentServices = setFromServiceReferences(dependency.Parents)
+	m.ChildServices = setFromServiceReferences(dependency.Children)
+	m.InheritsParent = types.BoolValue(dependency.InheritsParent)
+	m.ExecutionFailureCriteria = failureCriteriaFromCodes(dependency.ExecutionFailureCriteria, m.ExecutionFailureCriteria, validation.ServiceFailureCriteria)
+	m.NotificationFailureCriteria = failureCriteriaFromCodes(dependency.NotificationFailureCriteria, m.NotificationFailureCriteria, validation.ServiceFailureCriteria)
+	m.Comment = stringFromAPI(dependency.Comment, m.Comment)
+}
+
+func (r *serviceDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceDependencyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependencyReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating service dependency", map[string]interface{}{
+		"name": dependencyReq.Name,
+	})
+
+	dependency, err := r.client.CreateServiceDependency(dependencyReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service dependency",
+			fmt.Sprintf("Could not create service dependency: %v", err),
+		)
+		return
+	}
+	plan.refresh(dependency)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating service dependency",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceDependencyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependency, err := r.client.GetServiceDependency(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service dependency",
+			fmt.Sprintf("Could not read service dependency %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(dependency)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceDependencyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependencyReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateServiceDependency(id, dependencyReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service dependency",
+			fmt.Sprintf("Could not update service dependency %d: %v", id, err),
+		)
+		return
+	}
+
+	dependency, err := r.client.GetServiceDependency(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service dependency",
+			fmt.Sprintf("Could not read service dependency %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(dependency)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating service dependency",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceDependencyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteServiceDependency(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting service dependency",
+			fmt.Sprintf("Could not delete service dependency %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting service dependency",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *serviceDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
+
+type serviceReferenceModel struct {
+	HostID    types.Int64 `tfsdk:"host_id"`
+	ServiceID types.Int64 `tfsdk:"service_id"`
+}
+
+var serviceReferenceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host_id":    types.Int64Type,
+		"service_id": types.Int64Type,
+	},
+}
+
+// serviceReferenceSchema returns the nested attributes identifying a service.
+func serviceReferenceSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"host_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the host of the service",
+			},
+			"service_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Service ID",
+			},
+		},
+	}
+}
+
+// serviceReferencesFromSet converts a set of service references to the
+// payload expected by the API.
+func serviceReferencesFromSet(ctx context.Context, set types.Set) ([]client.ServiceReference, diag.Diagnostics) {
+	references := []client.ServiceReference{}
+	if set.IsNull() || set.IsUnknown() {
+		return references, nil
+	}
+
+	var models []serviceReferenceModel
+	diags := set.ElementsAs(ctx, &models, false)
+	for _, m := range models {
+		references = append(references, client.ServiceReference{
+			HostID:    int(m.HostID.ValueInt64()),
+			ServiceID: int(m.ServiceID.ValueInt64()),
+		})
+	}
+	return references, diags
+}
+
+// setFromServiceReferences converts service references returned by the API
+// to a set.
+func setFromServiceReferences(references []client.ServiceReference) types.Set {
+	elements := make([]attr.Value, len(references))
+	for i, reference := range references {
+		elements[i] = types.ObjectValueMust(serviceReferenceType.AttrTypes, map[string]attr.Value{
+			"host_id":    types.Int64Value(int64(reference.HostID)),
+			"service_id": types.Int64Value(int64(reference.ServiceID)),
+		})
+	}
+	return types.SetValueMust(serviceReferenceType, elements)
+}
+
+// optionalSetFromServiceReferences converts service references returned by
+// the API to a set. No references are kept null when the set is unset.
+func optionalSetFromServiceReferences(references []client.ServiceReference, current types.Set) types.Set {
+	if len(references) == 0 && current.IsNull() {
+		return types.SetNull(serviceReferenceType)
+	}
+	return setFromServiceReferences(references)
+}
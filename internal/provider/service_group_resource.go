@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serviceGroupResource{}
+	_ resource.ResourceWithImportState = &serviceGroupResource{}
+)
+
+func NewServiceGroupResource() resource.Resource {
+	return &serviceGroupResource{}
+}
+
+type serviceGroupResource struct {
+	client *client.Client
+}
+
+type serviceGroupResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	GeoCoords   types.String `tfsdk:"geo_coords"`
+	Comment     types.String `tfsdk:"comment"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+func (r *serviceGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_group"
+}
+
+func (r *serviceGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon service group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Service group ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service group name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service group alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"geo_coords": schema.StringAttribute{
+				Optional:    true,
+				Description: "Geographic coordinates, as \"latitude,longitude\"",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the service group is activated",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *serviceGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m serviceGroupResourceModel) request() *client.ServiceGroupRequest {
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	return &client.ServiceGroupRequest{
+		Name:        m.Name.ValueString(),
+		Alias:       alias,
+		GeoCoords:   optionalString(m.GeoCoords),
+		Comment:     optionalString(m.Comment),
+		IsActivated: m.IsActivated.ValueBool(),
+	}
+}
+
+// refresh updates the model with the service group returned by the API.
+func (m *serviceGroupResourceModel) refresh(group *client.ServiceGroup) {
+	m.ID = types.Int64Value(int64(group.ID))
+	m.Name = types.StringValue(group.Name)
+	m.Alias = types.StringValue(group.Alias)
+	m.GeoCoords = stringFromAPI(group.GeoCoords, m.GeoCoords)
+	m.Comment = stringFromAPI(group.Comment, m.Comment)
+	m.IsActivated = types.BoolValue(group.IsActivated)
+}
+
+func (r *serviceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating service group", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	group, err := r.client.CreateServiceGroup(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service group",
+			fmt.Sprintf("Could not create service group: %v", err),
+		)
+		return
+	}
+	plan.refresh(group)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating service group",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetServiceGroup(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service group",
+			fmt.Sprintf("Could not read service group %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(group)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateServiceGroup(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service group",
+			fmt.Sprintf("Could not update service group %d: %v", id, err),
+		)
+		return
+	}
+
+	group, err := r.client.GetServiceGroup(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service group",
+			fmt.Sprintf("Could not read service group %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(group)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating service group",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteServiceGroup(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting service group",
+			fmt.Sprintf("Could not delete service group %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting service group",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *serviceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serviceGroupsDataSource{}
+
+func NewServiceGroupsDataSource() datasource.DataSource {
+	return &serviceGroupsDataSource{}
+}
+
+type serviceGroupsDataSource struct {
+	client *client.Client
+}
+
+type serviceGroupDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	GeoCoords   types.String `tfsdk:"geo_coords"`
+	Comment     types.String `tfsdk:"comment"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+type serviceGroupsDataSourceModel struct {
+	Limit  types.Int64          `tfsdk:"limit"`
+	Page   types.Int64          `tfsdk:"page"`
+	Search *searchModel         `tfsdk:"search"`
+	Groups []serviceGroupDetail `tfsdk:"groups"`
+	Id     types.String         `tfsdk:"id"`
+}
+
+func (d *serviceGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_groups"
+}
+
+func (d *serviceGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of service groups.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "List of service groups",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Group ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Group name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Group alias",
+							Computed:    true,
+						},
+						"geo_coords": schema.StringAttribute{
+							Description: "Geographic coordinates",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the group is activated",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "Comment",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceGroupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupsResponse, err := d.client.GetServiceGroups(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Groups",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Groups = make([]serviceGroupDetail, len(groupsResponse.Result))
+	for i, group := range groupsResponse.Result {
+		state.Groups[i] = serviceGroupDetail{
+			ID:          types.Int64Value(int64(group.ID)),
+			Name:        types.StringValue(group.Name),
+			Alias:       types.StringValue(group.Alias),
+			GeoCoords:   stringFromAPI(group.GeoCoords, types.StringNull()),
+			Comment:     stringFromAPI(group.Comment, types.StringNull()),
+			IsActivated: types.BoolValue(group.IsActivated),
+		}
+	}
+
+	state.Id = types.StringValue("service_groups")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
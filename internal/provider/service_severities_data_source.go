@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serviceSeveritiesDataSource{}
+
+func NewServiceSeveritiesDataSource() datasource.DataSource {
+	return &serviceSeveritiesDataSource{}
+}
+
+type serviceSeveritiesDataSource struct {
+	client *client.Client
+}
+
+type serviceSeverityDetail struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	Level       types.Int64  `tfsdk:"level"`
+	IconID      types.Int64  `tfsdk:"icon_id"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+type serviceSeveritiesDataSourceModel struct {
+	Limit      types.Int64             `tfsdk:"limit"`
+	Page       types.Int64             `tfsdk:"page"`
+	Search     *searchModel            `tfsdk:"search"`
+	Severities []serviceSeverityDetail `tfsdk:"severities"`
+	Id         types.String            `tfsdk:"id"`
+}
+
+func (d *serviceSeveritiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_severities"
+}
+
+func (d *serviceSeveritiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of service severities.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Description: "Number of results to return",
+				Required:    true,
+			},
+			"page": schema.Int64Attribute{
+				Description: "Page number",
+				Required:    true,
+			},
+			"search": schema.SingleNestedAttribute{
+				Description: "Search criteria",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Field name to search",
+						Optional:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "Value to search for",
+						Optional:    true,
+					},
+				},
+			},
+			"severities": schema.ListNestedAttribute{
+				Description: "List of service severities",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Severity ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Severity name",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "Severity alias",
+							Computed:    true,
+						},
+						"level": schema.Int64Attribute{
+							Description: "Severity level",
+							Computed:    true,
+						},
+						"icon_id": schema.Int64Attribute{
+							Description: "Severity icon ID",
+							Computed:    true,
+						},
+						"is_activated": schema.BoolAttribute{
+							Description: "Whether the severity is activated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceSeveritiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceSeveritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceSeveritiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severitiesResponse, err := d.client.GetServiceSeverities(
+		int(state.Limit.ValueInt64()),
+		int(state.Page.ValueInt64()),
+		searchQuery(state.Search),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Severities",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to model
+	state.Severities = make([]serviceSeverityDetail, len(severitiesResponse.Result))
+	for i, severity := range severitiesResponse.Result {
+		state.Severities[i] = serviceSeverityDetail{
+			ID:          types.Int64Value(int64(severity.ID)),
+			Name:        types.StringValue(severity.Name),
+			Alias:       types.StringValue(severity.Alias),
+			Level:       types.Int64Value(int64(severity.Level)),
+			IconID:      types.Int64Value(int64(severity.IconID)),
+			IsActivated: types.BoolValue(severity.IsActivated),
+		}
+	}
+
+	state.Id = types.StringValue("service_severities")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serviceSeverityResource{}
+	_ resource.ResourceWithImportState = &serviceSeverityResource{}
+)
+
+func NewServiceSeverityResource() resource.Resource {
+	return &serviceSeverityResource{}
+}
+
+type serviceSeverityResource struct {
+	client *client.Client
+}
+
+type serviceSeverityResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Alias       types.String `tfsdk:"alias"`
+	Level       types.Int64  `tfsdk:"level"`
+	IconID      types.Int64  `tfsdk:"icon_id"`
+	IsActivated types.Bool   `tfsdk:"is_activated"`
+}
+
+func (r *serviceSeverityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_severity"
+}
+
+func (r *serviceSeverityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon service severity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Service severity ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Service severity name",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Service severity alias. Defaults to the name on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"level": schema.Int64Attribute{
+				Required:    true,
+				Description: "Severity level, lower levels being more severe",
+				Validators: []validator.Int64{
+					validation.Int64BetweenValidator{Min: -128, Max: 127},
+				},
+			},
+			"icon_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the icon displayed for the severity",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the service severity is activated",
+			},
+		},
+	}
+}
+
+func (r *serviceSeverityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan, the alias defaulting to the name.
+func (m serviceSeverityResourceModel) request() *client.ServiceSeverityRequest {
+	alias := m.Name.ValueString()
+	if !m.Alias.IsNull() && !m.Alias.IsUnknown() {
+		alias = m.Alias.ValueString()
+	}
+
+	return &client.ServiceSeverityRequest{
+		Name:        m.Name.ValueString(),
+		Alias:       alias,
+		Level:       int(m.Level.ValueInt64()),
+		IconID:      int(m.IconID.ValueInt64()),
+		IsActivated: m.IsActivated.ValueBool(),
+	}
+}
+
+// refresh updates the model with the service severity returned by the API.
+func (m *serviceSeverityResourceModel) refresh(severity *client.ServiceSeverity) {
+	m.ID = types.Int64Value(int64(severity.ID))
+	m.Name = types.StringValue(severity.Name)
+	m.Alias = types.StringValue(severity.Alias)
+	m.Level = types.Int64Value(int64(severity.Level))
+	m.IconID = types.Int64Value(int64(severity.IconID))
+	m.IsActivated = types.BoolValue(severity.IsActivated)
+}
+
+func (r *serviceSeverityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceSeverityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating service severity", map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+
+	severity, err := r.client.CreateServiceSeverity(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating service severity",
+			fmt.Sprintf("Could not create service severity: %v", err),
+		)
+		return
+	}
+	plan.refresh(severity)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating service severity",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceSeverityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceSeverityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severity, err := r.client.GetServiceSeverity(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service severity",
+			fmt.Sprintf("Could not read service severity %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(severity)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *serviceSeverityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serviceSeverityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateServiceSeverity(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating service severity",
+			fmt.Sprintf("Could not update service severity %d: %v", id, err),
+		)
+		return
+	}
+
+	severity, err := r.client.GetServiceSeverity(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading service severity",
+			fmt.Sprintf("Could not read service severity %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(severity)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating service severity",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *serviceSeverityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceSeverityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteServiceSeverity(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting service severity",
+			fmt.Sprintf("Could not delete service severity %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting service severity",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *serviceSeverityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
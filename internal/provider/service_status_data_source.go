@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &serviceStatusDataSource{}
+
+func NewServiceStatusDataSource() datasource.DataSource {
+	return &serviceStatusDataSource{}
+}
+
+type serviceStatusDataSource struct {
+	client *client.Client
+}
+
+type serviceStatusDataSourceModel struct {
+	HostID          types.Int64  `tfsdk:"host_id"`
+	ServiceID       types.Int64  `tfsdk:"service_id"`
+	Name            types.String `tfsdk:"name"`
+	State           types.String `tfsdk:"state"`
+	StateCode       types.Int64  `tfsdk:"state_code"`
+	Output          types.String `tfsdk:"output"`
+	LastCheck       types.String `tfsdk:"last_check"`
+	LastStateChange types.String `tfsdk:"last_state_change"`
+	Acknowledged    types.Bool   `tfsdk:"acknowledged"`
+	InDowntime      types.Bool   `tfsdk:"in_downtime"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (d *serviceStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_status"
+}
+
+func (d *serviceStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the real-time status of a service from the monitoring.",
+		Attributes: map[string]schema.Attribute{
+			"host_id": schema.Int64Attribute{
+				Description: "ID of the host of the service",
+				Required:    true,
+			},
+			"service_id": schema.Int64Attribute{
+				Description: "Service ID",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Service description",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Current state: OK, WARNING, CRITICAL, UNKNOWN or PENDING",
+				Computed:    true,
+			},
+			"state_code": schema.Int64Attribute{
+				Description: "Current state code: 0 for OK, 1 for WARNING, 2 for CRITICAL, 3 for UNKNOWN and 4 for PENDING",
+				Computed:    true,
+			},
+			"output": schema.StringAttribute{
+				Description: "Output of the last check",
+				Computed:    true,
+			},
+			"last_check": schema.StringAttribute{
+				Description: "Time of the last check, in RFC 3339 format. Null until the service is checked",
+				Computed:    true,
+			},
+			"last_state_change": schema.StringAttribute{
+				Description: "Time of the last state change, in RFC 3339 format",
+				Computed:    true,
+			},
+			"acknowledged": schema.BoolAttribute{
+				Description: "Whether the service problem is acknowledged",
+				Computed:    true,
+			},
+			"in_downtime": schema.BoolAttribute{
+				Description: "Whether the service is in downtime",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serviceStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceState, err := d.client.GetServiceState(int(state.HostID.ValueInt64()), int(state.ServiceID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Status",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(serviceState.Name)
+	state.State = types.StringValue(serviceState.Status.Name)
+	state.StateCode = types.Int64Value(int64(serviceState.Status.Code))
+	state.Output = types.StringValue(serviceState.Information)
+	state.LastCheck = timeValue(serviceState.LastCheck)
+	state.LastStateChange = timeValue(serviceState.LastStatusChange)
+	state.Acknowledged = types.BoolValue(serviceState.IsAcknowledged)
+	state.InDowntime = types.BoolValue(serviceState.IsInDowntime)
+	state.Id = types.StringValue(fmt.Sprintf("%d/%d", state.HostID.ValueInt64(), serviceState.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
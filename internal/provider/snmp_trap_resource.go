@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+	"terraform-provider-centreon/internal/validation"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &snmpTrapResource{}
+	_ resource.ResourceWithImportState = &snmpTrapResource{}
+)
+
+func NewSNMPTrapResource() resource.Resource {
+	return &snmpTrapResource{}
+}
+
+type snmpTrapResource struct {
+	client *client.Client
+}
+
+type snmpTrapResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	OID                types.String `tfsdk:"oid"`
+	VendorID           types.Int64  `tfsdk:"vendor_id"`
+	Output             types.String `tfsdk:"output"`
+	Status             types.String `tfsdk:"status"`
+	MatchingRules      types.List   `tfsdk:"matching_rules"`
+	SubmitResult       types.Bool   `tfsdk:"submit_result"`
+	RescheduleChecks   types.Bool   `tfsdk:"reschedule_checks"`
+	ExecuteCommand     types.String `tfsdk:"execute_command"`
+	ServiceTemplateIDs types.Set    `tfsdk:"service_template_ids"`
+	Comment            types.String `tfsdk:"comment"`
+}
+
+// snmpTrapMatchingRuleModel sets the status of a trap when regexp matches.
+type snmpTrapMatchingRuleModel struct {
+	String types.String `tfsdk:"string"`
+	Regexp types.String `tfsdk:"regexp"`
+	Status types.String `tfsdk:"status"`
+}
+
+var snmpTrapMatchingRuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"string": types.StringType,
+		"regexp": types.StringType,
+		"status": types.StringType,
+	},
+}
+
+func (r *snmpTrapResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_trap"
+}
+
+func (r *snmpTrapResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	statuses := "One of ok, warning, critical or unknown"
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon SNMP trap definition. Received traps matching the OID become check results " +
+			"of the services created from the linked service templates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "SNMP trap ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Trap name",
+			},
+			"oid": schema.StringAttribute{
+				Required:    true,
+				Description: "Numeric OID of the trap, such as .1.3.6.1.6.3.1.1.5.3",
+				Validators: []validator.String{
+					validation.OIDValidator{},
+				},
+			},
+			"vendor_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the vendor of the trap",
+			},
+			"output": schema.StringAttribute{
+				Required:    true,
+				Description: "Output message of the check result, where $1, $2... are replaced by the trap arguments and $* by all of them",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ok"),
+				Description: "Status of the check result when no matching rule matches. " + statuses + ". Defaults to ok",
+				Validators: []validator.String{
+					validation.OneOfValidator{Values: validation.ServiceStatuses},
+				},
+			},
+			"matching_rules": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Rules setting the status of the check result, evaluated in order",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"string": schema.StringAttribute{
+							Required:    true,
+							Description: "String the regexp is applied to, such as @OUTPUT@ for the output message or $2 for a trap argument",
+						},
+						"regexp": schema.StringAttribute{
+							Required:    true,
+							Description: "Regular expression, such as /down/i",
+						},
+						"status": schema.StringAttribute{
+							Required:    true,
+							Description: "Status of the check result when the regexp matches. " + statuses,
+							Validators: []validator.String{
+								validation.OneOfValidator{Values: validation.ServiceStatuses},
+							},
+						},
+					},
+				},
+			},
+			"submit_result": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the trap is submitted as a check result",
+			},
+			"reschedule_checks": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether an active check of the service is scheduled when the trap is received",
+			},
+			"execute_command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command run when the trap is received",
+			},
+			"service_template_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the service templates linked to the trap",
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Comment",
+			},
+		},
+	}
+}
+
+func (r *snmpTrapResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan.
+func (m snmpTrapResourceModel) request(ctx context.Context) (*client.SNMPTrapRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	status, err := validation.ServiceStatusCode(m.Status.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("status"), "Invalid Status", err.Error())
+	}
+
+	rules := []client.SNMPTrapMatchingRule{}
+	if !m.MatchingRules.IsNull() && !m.MatchingRules.IsUnknown() {
+		var models []snmpTrapMatchingRuleModel
+		diags.Append(m.MatchingRules.ElementsAs(ctx, &models, false)...)
+		for i, rule := range models {
+			ruleStatus, err := validation.ServiceStatusCode(rule.Status.ValueString())
+			if err != nil {
+				diags.AddAttributeError(path.Root("matching_rules").AtListIndex(i).AtName("status"), "Invalid Status", err.Error())
+			}
+			rules = append(rules, client.SNMPTrapMatchingRule{
+				String: rule.String.ValueString(),
+				Regexp: rule.Regexp.ValueString(),
+				Status: ruleStatus,
+				Order:  i + 1,
+			})
+		}
+	}
+
+	templates, d := intsFromSet(ctx, m.ServiceTemplateIDs)
+	diags.Append(d...)
+
+	return &client.SNMPTrapRequest{
+		Name:             m.Name.ValueString(),
+		OID:              m.OID.ValueString(),
+		VendorID:         optionalInt64(m.VendorID),
+		Output:           m.Output.ValueString(),
+		Status:           status,
+		MatchingRules:    rules,
+		SubmitResult:     m.SubmitResult.ValueBool(),
+		RescheduleChecks: m.RescheduleChecks.ValueBool(),
+		ExecuteCommand:   optionalString(m.ExecuteCommand),
+		ServiceTemplates: nonNilInts(templates),
+		Comment:          optionalString(m.Comment),
+	}, diags
+}
+
+// refresh updates the model with the SNMP trap returned by the API.
+func (m *snmpTrapResourceModel) refresh(trap *client.SNMPTrap) {
+	m.ID = types.Int64Value(int64(trap.ID))
+	m.Name = types.StringValue(trap.Name)
+	m.OID = types.StringValue(trap.OID)
+	m.VendorID = int64FromAPI(trap.VendorID)
+	m.Output = types.StringValue(trap.Output)
+	m.Status = types.StringValue(validation.ServiceStatusName(trap.Status))
+
+	rules := append([]client.SNMPTrapMatchingRule(nil), trap.MatchingRules...)
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].Order < rules[j].Order })
+	if len(rules) == 0 && m.MatchingRules.IsNull() {
+		m.MatchingRules = types.ListNull(snmpTrapMatchingRuleType)
+	} else {
+		elements := make([]attr.Value, len(rules))
+		for i, rule := range rules {
+			elements[i] = types.ObjectValueMust(snmpTrapMatchingRuleType.AttrTypes, map[string]attr.Value{
+				"string": types.StringValue(rule.String),
+				"regexp": types.StringValue(rule.Regexp),
+				"status": types.StringValue(validation.ServiceStatusName(rule.Status)),
+			})
+		}
+		m.MatchingRules = types.ListValueMust(snmpTrapMatchingRuleType, elements)
+	}
+
+	m.SubmitResult = types.BoolValue(trap.SubmitResult)
+	m.RescheduleChecks = types.BoolValue(trap.RescheduleChecks)
+	m.ExecuteCommand = stringFromAPI(trap.ExecuteCommand, m.ExecuteCommand)
+	m.ServiceTemplateIDs = optionalSetFromInts(trap.ServiceTemplates, m.ServiceTemplateIDs)
+	m.Comment = stringFromAPI(trap.Comment, m.Comment)
+}
+
+func (r *snmpTrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snmpTrapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trapReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating SNMP trap", map[string]interface{}{
+		"name": trapReq.Name,
+	})
+
+	trap, err := r.client.CreateSNMPTrap(trapReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SNMP trap",
+			fmt.Sprintf("Could not create SNMP trap: %v", err),
+		)
+		return
+	}
+	plan.refresh(trap)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating SNMP trap",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *snmpTrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snmpTrapResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trap, err := r.client.GetSNMPTrap(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading SNMP trap",
+			fmt.Sprintf("Could not read SNMP trap %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(trap)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *snmpTrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan snmpTrapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trapReq, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateSNMPTrap(id, trapReq); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating SNMP trap",
+			fmt.Sprintf("Could not update SNMP trap %d: %v", id, err),
+		)
+		return
+	}
+
+	trap, err := r.client.GetSNMPTrap(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading SNMP trap",
+			fmt.Sprintf("Could not read SNMP trap %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(trap)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating SNMP trap",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *snmpTrapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state snmpTrapResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteSNMPTrap(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting SNMP trap",
+			fmt.Sprintf("Could not delete SNMP trap %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting SNMP trap",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *snmpTrapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-centreon/internal/client"
+	"terraform-provider-centreon/internal/logging"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	client *client.Client
+}
+
+type userResourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Alias                    types.String `tfsdk:"alias"`
+	Email                    types.String `tfsdk:"email"`
+	Password                 types.String `tfsdk:"password"`
+	IsAdmin                  types.Bool   `tfsdk:"is_admin"`
+	IsActivated              types.Bool   `tfsdk:"is_activated"`
+	CanReachFrontend         types.Bool   `tfsdk:"can_reach_frontend"`
+	CanReachConfigurationAPI types.Bool   `tfsdk:"can_reach_configuration_api"`
+	CanReachRealtimeAPI      types.Bool   `tfsdk:"can_reach_realtime_api"`
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Centreon user, such as a service account used by another team through the API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "User ID",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Full name of the user",
+			},
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: "Login of the user",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address of the user",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the user. Passwords are never read back, so changes made outside Terraform aren't detected",
+			},
+			"is_admin": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user is an administrator, bypassing access control",
+			},
+			"is_activated": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the user is enabled",
+			},
+			"can_reach_frontend": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the user can log in to the web interface",
+			},
+			"can_reach_configuration_api": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user can use the configuration API",
+			},
+			"can_reach_realtime_api": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the user can use the real-time monitoring API",
+			},
+		},
+	}
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// request builds the API payload from the plan.
+func (m userResourceModel) request() *client.UserRequest {
+	return &client.UserRequest{
+		Name:                     m.Name.ValueString(),
+		Alias:                    m.Alias.ValueString(),
+		Email:                    m.Email.ValueString(),
+		Password:                 optionalString(m.Password),
+		IsAdmin:                  m.IsAdmin.ValueBool(),
+		IsActivated:              m.IsActivated.ValueBool(),
+		CanReachFrontend:         m.CanReachFrontend.ValueBool(),
+		CanReachConfigurationAPI: m.CanReachConfigurationAPI.ValueBool(),
+		CanReachRealtimeAPI:      m.CanReachRealtimeAPI.ValueBool(),
+	}
+}
+
+// refresh updates the model with the user returned by the API. The password
+// isn't returned, so the current value is kept.
+func (m *userResourceModel) refresh(user *client.User) {
+	m.ID = types.Int64Value(int64(user.ID))
+	m.Name = types.StringValue(user.Name)
+	m.Alias = types.StringValue(user.Alias)
+	m.Email = types.StringValue(user.Email)
+	m.IsAdmin = types.BoolValue(user.IsAdmin)
+	m.IsActivated = types.BoolValue(user.IsActivated)
+	m.CanReachFrontend = types.BoolValue(user.CanReachFrontend)
+	m.CanReachConfigurationAPI = types.BoolValue(user.CanReachConfigurationAPI)
+	m.CanReachRealtimeAPI = types.BoolValue(user.CanReachRealtimeAPI)
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logging.Info(ctx, "Creating user", map[string]interface{}{
+		"alias": plan.Alias.ValueString(),
+	})
+
+	user, err := r.client.CreateUser(plan.request())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			fmt.Sprintf("Could not create user: %v", err),
+		)
+		return
+	}
+	plan.refresh(user)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after creating user",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(int(state.ID.ValueInt64()))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read user %d: %v", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+	state.refresh(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(plan.ID.ValueInt64())
+	if err := r.client.UpdateUser(id, plan.request()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating user",
+			fmt.Sprintf("Could not update user %d: %v", id, err),
+		)
+		return
+	}
+
+	user, err := r.client.GetUser(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			fmt.Sprintf("Could not read user %d after update: %v", id, err),
+		)
+		return
+	}
+	plan.refresh(user)
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after updating user",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(state.ID.ValueInt64())
+	if err := r.client.DeleteUser(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting user",
+			fmt.Sprintf("Could not delete user %d: %v", id, err),
+		)
+		return
+	}
+
+	if err := handleConfigurationReload(r.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error after deleting user",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateID(ctx, req, resp)
+}
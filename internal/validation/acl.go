@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ACLAction is an action that ACL action accesses allow on the monitoring.
+type ACLAction string
+
+// ACL actions, named as in the Centreon ACL action access form.
+const (
+	ACLActionTopCounter                   ACLAction = "top_counter"
+	ACLActionPollerStats                  ACLAction = "poller_stats"
+	ACLActionPollerListing                ACLAction = "poller_listing"
+	ACLActionGenerateConfiguration        ACLAction = "generate_cfg"
+	ACLActionCreateEditPollerConfig       ACLAction = "create_edit_poller_cfg"
+	ACLActionDeletePollerConfig           ACLAction = "delete_poller_cfg"
+	ACLActionGlobalShutdown               ACLAction = "global_shutdown"
+	ACLActionGlobalRestart                ACLAction = "global_restart"
+	ACLActionGlobalNotifications          ACLAction = "global_notifications"
+	ACLActionGlobalServiceChecks          ACLAction = "global_service_checks"
+	ACLActionGlobalServicePassiveChecks   ACLAction = "global_service_passive_checks"
+	ACLActionGlobalHostChecks             ACLAction = "global_host_checks"
+	ACLActionGlobalHostPassiveChecks      ACLAction = "global_host_passive_checks"
+	ACLActionGlobalEventHandler           ACLAction = "global_event_handler"
+	ACLActionGlobalFlapDetection          ACLAction = "global_flap_detection"
+	ACLActionGlobalServiceObsess          ACLAction = "global_service_obsess"
+	ACLActionGlobalHostObsess             ACLAction = "global_host_obsess"
+	ACLActionGlobalPerfData               ACLAction = "global_perf_data"
+	ACLActionServiceChecks                ACLAction = "service_checks"
+	ACLActionServiceNotifications         ACLAction = "service_notifications"
+	ACLActionServiceAcknowledgement       ACLAction = "service_acknowledgement"
+	ACLActionServiceDisacknowledgement    ACLAction = "service_disacknowledgement"
+	ACLActionServiceScheduleCheck         ACLAction = "service_schedule_check"
+	ACLActionServiceScheduleForcedCheck   ACLAction = "service_schedule_forced_check"
+	ACLActionServiceScheduleDowntime      ACLAction = "service_schedule_downtime"
+	ACLActionServiceComment               ACLAction = "service_comment"
+	ACLActionServiceEventHandler          ACLAction = "service_event_handler"
+	ACLActionServiceFlapDetection         ACLAction = "service_flap_detection"
+	ACLActionServicePassiveChecks         ACLAction = "service_passive_checks"
+	ACLActionServiceSubmitResult          ACLAction = "service_submit_result"
+	ACLActionServiceDisplayCommand        ACLAction = "service_display_command"
+	ACLActionHostChecks                   ACLAction = "host_checks"
+	ACLActionHostNotifications            ACLAction = "host_notifications"
+	ACLActionHostAcknowledgement          ACLAction = "host_acknowledgement"
+	ACLActionHostDisacknowledgement       ACLAction = "host_disacknowledgement"
+	ACLActionHostScheduleCheck            ACLAction = "host_schedule_check"
+	ACLActionHostScheduleForcedCheck      ACLAction = "host_schedule_forced_check"
+	ACLActionHostScheduleDowntime         ACLAction = "host_schedule_downtime"
+	ACLActionHostComment                  ACLAction = "host_comment"
+	ACLActionHostEventHandler             ACLAction = "host_event_handler"
+	ACLActionHostFlapDetection            ACLAction = "host_flap_detection"
+	ACLActionHostChecksForServices        ACLAction = "host_checks_for_services"
+	ACLActionHostNotificationsForServices ACLAction = "host_notifications_for_services"
+	ACLActionHostSubmitResult             ACLAction = "host_submit_result"
+)
+
+// ACLActions lists the ACL actions.
+var ACLActions = []ACLAction{
+	ACLActionTopCounter,
+	ACLActionPollerStats,
+	ACLActionPollerListing,
+	ACLActionGenerateConfiguration,
+	ACLActionCreateEditPollerConfig,
+	ACLActionDeletePollerConfig,
+	ACLActionGlobalShutdown,
+	ACLActionGlobalRestart,
+	ACLActionGlobalNotifications,
+	ACLActionGlobalServiceChecks,
+	ACLActionGlobalServicePassiveChecks,
+	ACLActionGlobalHostChecks,
+	ACLActionGlobalHostPassiveChecks,
+	ACLActionGlobalEventHandler,
+	ACLActionGlobalFlapDetection,
+	ACLActionGlobalServiceObsess,
+	ACLActionGlobalHostObsess,
+	ACLActionGlobalPerfData,
+	ACLActionServiceChecks,
+	ACLActionServiceNotifications,
+	ACLActionServiceAcknowledgement,
+	ACLActionServiceDisacknowledgement,
+	ACLActionServiceScheduleCheck,
+	ACLActionServiceScheduleForcedCheck,
+	ACLActionServiceScheduleDowntime,
+	ACLActionServiceComment,
+	ACLActionServiceEventHandler,
+	ACLActionServiceFlapDetection,
+	ACLActionServicePassiveChecks,
+	ACLActionServiceSubmitResult,
+	ACLActionServiceDisplayCommand,
+	ACLActionHostChecks,
+	ACLActionHostNotifications,
+	ACLActionHostAcknowledgement,
+	ACLActionHostDisacknowledgement,
+	ACLActionHostScheduleCheck,
+	ACLActionHostScheduleForcedCheck,
+	ACLActionHostScheduleDowntime,
+	ACLActionHostComment,
+	ACLActionHostEventHandler,
+	ACLActionHostFlapDetection,
+	ACLActionHostChecksForServices,
+	ACLActionHostNotificationsForServices,
+	ACLActionHostSubmitResult,
+}
+
+// ParseACLAction returns the ACL action with the given name.
+func ParseACLAction(name string) (ACLAction, error) {
+	for _, action := range ACLActions {
+		if string(action) == name {
+			return action, nil
+		}
+	}
+	return "", fmt.Errorf("unknown ACL action: %s", name)
+}
+
+// ACLActionNames returns the names of the ACL actions.
+func ACLActionNames() []string {
+	names := make([]string, len(ACLActions))
+	for i, action := range ACLActions {
+		names[i] = string(action)
+	}
+	return names
+}
+
+// ACLActionsValidator validates that every element of a set is an ACL action.
+type ACLActionsValidator struct{}
+
+func (v ACLActionsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be one of: %s", strings.Join(ACLActionNames(), ", "))
+}
+
+func (v ACLActionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ACLActionsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := ParseACLAction(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid ACL Action",
+				fmt.Sprintf("ACL action must be one of: %s. Got: %s",
+					strings.Join(ACLActionNames(), ", "), value.ValueString()),
+			)
+		}
+	}
+}
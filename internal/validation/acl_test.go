@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseACLAction(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, name := range ACLActionNames() {
+		if seen[name] {
+			t.Errorf("duplicate ACL action %s", name)
+		}
+		seen[name] = true
+
+		action, err := ParseACLAction(name)
+		if err != nil || string(action) != name {
+			t.Errorf("ParseACLAction(%q) = %q, %v", name, action, err)
+		}
+	}
+	if len(seen) != len(ACLActions) {
+		t.Errorf("ACLActionNames() returned %d names for %d actions", len(seen), len(ACLActions))
+	}
+
+	for _, name := range []string{"", "generate_configuration", "HOST_CHECKS"} {
+		if _, err := ParseACLAction(name); err == nil {
+			t.Errorf("ParseACLAction(%q) accepted an unknown action", name)
+		}
+	}
+}
+
+func TestACLActionsValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.Set
+		wantErr bool
+	}{
+		{name: "actions", value: stringSet("generate_cfg", "host_checks")},
+		{name: "empty", value: stringSet()},
+		{name: "null", value: types.SetNull(types.StringType)},
+		{name: "unknown", value: types.SetUnknown(types.StringType)},
+		{name: "unknown action", value: stringSet("generate_cfg", "restart_everything"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateSet(ACLActionsValidator{}, tt.value); got != tt.wantErr {
+				t.Errorf("error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configValue returns the configured value at the given path. The second
+// return value is false when the value is null or unknown.
+func configValue(ctx context.Context, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) (attr.Value, bool) {
+	var value attr.Value
+	diags.Append(config.GetAttribute(ctx, p, &value)...)
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return value, false
+	}
+	return value, true
+}
+
+// isUnknown reports whether the configured value at the given path is unknown.
+func isUnknown(ctx context.Context, config tfsdk.Config, p path.Path, diags *diag.Diagnostics) bool {
+	value, _ := configValue(ctx, config, p, diags)
+	return value != nil && value.IsUnknown()
+}
+
+// pathNames joins paths for use in descriptions.
+func pathNames(paths []path.Path) string {
+	names := make([]string, len(paths))
+	for i, p := range paths {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// ConflictingAttributesValidator validates that at most one of the attributes is set.
+type ConflictingAttributesValidator struct {
+	Paths []path.Path
+}
+
+var _ resource.ConfigValidator = ConflictingAttributesValidator{}
+
+func (v ConflictingAttributesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("only one of %s can be set", pathNames(v.Paths))
+}
+
+func (v ConflictingAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ConflictingAttributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set []path.Path
+	for _, p := range v.Paths {
+		value, _ := configValue(ctx, req.Config, p, &resp.Diagnostics)
+		if value != nil && !value.IsNull() {
+			set = append(set, p)
+		}
+	}
+
+	if len(set) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			set[1],
+			"Conflicting Attributes",
+			fmt.Sprintf("Only one of %s can be set, got: %s.", pathNames(v.Paths), pathNames(set)),
+		)
+	}
+}
+
+// ExactlyOneOfValidator validates that exactly one of the attributes is set.
+type ExactlyOneOfValidator struct {
+	Paths []path.Path
+}
+
+var _ resource.ConfigValidator = ExactlyOneOfValidator{}
+
+func (v ExactlyOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("exactly one of %s must be set", pathNames(v.Paths))
+}
+
+func (v ExactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ExactlyOneOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ConflictingAttributesValidator(v).ValidateResource(ctx, req, resp)
+
+	for _, p := range v.Paths {
+		value, _ := configValue(ctx, req.Config, p, &resp.Diagnostics)
+		if value != nil && !value.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		v.Paths[0],
+		"Missing Attribute",
+		fmt.Sprintf("Exactly one of %s must be set.", pathNames(v.Paths)),
+	)
+}
+
+// RequiresAttributeValidator validates that Required is set whenever Attribute is set.
+type RequiresAttributeValidator struct {
+	Attribute path.Path
+	Required  path.Path
+}
+
+var _ resource.ConfigValidator = RequiresAttributeValidator{}
+
+func (v RequiresAttributeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s requires %s to be set", v.Attribute, v.Required)
+}
+
+func (v RequiresAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v RequiresAttributeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if _, ok := configValue(ctx, req.Config, v.Attribute, &resp.Diagnostics); !ok {
+		return
+	}
+
+	required, _ := configValue(ctx, req.Config, v.Required, &resp.Diagnostics)
+	if required == nil || required.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			v.Attribute,
+			"Missing Required Attribute",
+			fmt.Sprintf("%s can only be set when %s is set.", v.Attribute, v.Required),
+		)
+	}
+}
+
+// RequiresTrueValidator validates that the boolean Required is true whenever
+// Attribute is set.
+type RequiresTrueValidator struct {
+	Attribute path.Path
+	Required  path.Path
+}
+
+var _ resource.ConfigValidator = RequiresTrueValidator{}
+
+func (v RequiresTrueValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s requires %s to be true", v.Attribute, v.Required)
+}
+
+func (v RequiresTrueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v RequiresTrueValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if _, ok := configValue(ctx, req.Config, v.Attribute, &resp.Diagnostics); !ok {
+		return
+	}
+	if isUnknown(ctx, req.Config, v.Required, &resp.Diagnostics) {
+		return
+	}
+
+	required, _ := configValue(ctx, req.Config, v.Required, &resp.Diagnostics)
+	if value, ok := required.(types.Bool); !ok || value.IsNull() || !value.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			v.Attribute,
+			"Missing Required Attribute",
+			fmt.Sprintf("%s can only be set when %s is true.", v.Attribute, v.Required),
+		)
+	}
+}
+
+// Int64LessThanOrEqualValidator validates that the integer Low does not
+// exceed the integer High when both are set.
+type Int64LessThanOrEqualValidator struct {
+	Low  path.Path
+	High path.Path
+}
+
+var _ resource.ConfigValidator = Int64LessThanOrEqualValidator{}
+
+func (v Int64LessThanOrEqualValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("%s must be less than or equal to %s", v.Low, v.High)
+}
+
+func (v Int64LessThanOrEqualValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v Int64LessThanOrEqualValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	low, lowOK := configValue(ctx, req.Config, v.Low, &resp.Diagnostics)
+	high, highOK := configValue(ctx, req.Config, v.High, &resp.Diagnostics)
+	if !lowOK || !highOK {
+		return
+	}
+
+	lowValue, lowInt := low.(types.Int64)
+	highValue, highInt := high.(types.Int64)
+	if !lowInt || !highInt {
+		return
+	}
+
+	if lowValue.ValueInt64() > highValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			v.Low,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s (%d) must be less than or equal to %s (%d).",
+				v.Low, lowValue.ValueInt64(), v.High, highValue.ValueInt64()),
+		)
+	}
+}
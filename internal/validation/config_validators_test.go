@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testConfigSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"a":       schema.StringAttribute{Optional: true},
+		"b":       schema.StringAttribute{Optional: true},
+		"enabled": schema.BoolAttribute{Optional: true},
+		"low":     schema.Int64Attribute{Optional: true},
+		"high":    schema.Int64Attribute{Optional: true},
+	},
+}
+
+// testConfig returns a configuration of testConfigSchema with the given
+// values, the other attributes being null.
+func testConfig(values map[string]interface{}) tfsdk.Config {
+	ctx := context.Background()
+	objectType := testConfigSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, values[name])
+	}
+	return tfsdk.Config{
+		Schema: testConfigSchema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestConfigValidators(t *testing.T) {
+	a, b := path.Root("a"), path.Root("b")
+	enabled := path.Root("enabled")
+	low, high := path.Root("low"), path.Root("high")
+
+	tests := []struct {
+		name      string
+		validator resource.ConfigValidator
+		values    map[string]interface{}
+		wantErr   bool
+	}{
+		{name: "conflicting, none set", validator: ConflictingAttributesValidator{Paths: []path.Path{a, b}}},
+		{name: "conflicting, one set", validator: ConflictingAttributesValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"a": "x"}},
+		{name: "conflicting, both set", validator: ConflictingAttributesValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"a": "x", "b": "y"}, wantErr: true},
+		{name: "conflicting, one unknown", validator: ConflictingAttributesValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"a": "x", "b": tftypes.UnknownValue}, wantErr: true},
+		{name: "exactly one, none set", validator: ExactlyOneOfValidator{Paths: []path.Path{a, b}}, wantErr: true},
+		{name: "exactly one, one set", validator: ExactlyOneOfValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"b": "y"}},
+		{name: "exactly one, one unknown", validator: ExactlyOneOfValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"a": tftypes.UnknownValue}},
+		{name: "exactly one, both set", validator: ExactlyOneOfValidator{Paths: []path.Path{a, b}}, values: map[string]interface{}{"a": "x", "b": "y"}, wantErr: true},
+		{name: "requires, unset", validator: RequiresAttributeValidator{Attribute: a, Required: b}},
+		{name: "requires, set", validator: RequiresAttributeValidator{Attribute: a, Required: b}, values: map[string]interface{}{"a": "x", "b": "y"}},
+		{name: "requires, missing", validator: RequiresAttributeValidator{Attribute: a, Required: b}, values: map[string]interface{}{"a": "x"}, wantErr: true},
+		{name: "requires true, true", validator: RequiresTrueValidator{Attribute: a, Required: enabled}, values: map[string]interface{}{"a": "x", "enabled": true}},
+		{name: "requires true, false", validator: RequiresTrueValidator{Attribute: a, Required: enabled}, values: map[string]interface{}{"a": "x", "enabled": false}, wantErr: true},
+		{name: "requires true, null", validator: RequiresTrueValidator{Attribute: a, Required: enabled}, values: map[string]interface{}{"a": "x"}, wantErr: true},
+		{name: "requires true, unknown", validator: RequiresTrueValidator{Attribute: a, Required: enabled}, values: map[string]interface{}{"a": "x", "enabled": tftypes.UnknownValue}},
+		{name: "less than or equal, equal", validator: Int64LessThanOrEqualValidator{Low: low, High: high}, values: map[string]interface{}{"low": 5, "high": 5}},
+		{name: "less than or equal, greater", validator: Int64LessThanOrEqualValidator{Low: low, High: high}, values: map[string]interface{}{"low": 6, "high": 5}, wantErr: true},
+		{name: "less than or equal, one set", validator: Int64LessThanOrEqualValidator{Low: low, High: high}, values: map[string]interface{}{"low": 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: testConfig(tt.values)}
+			var resp resource.ValidateConfigResponse
+			tt.validator.ValidateResource(context.Background(), req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
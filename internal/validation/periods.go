@@ -0,0 +1,242 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Weekdays lists the day names accepted in periods, numbered from 1 (monday)
+// to 7 (sunday) by the API.
+var Weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// WeekdayNumber returns the API number of the given day name.
+func WeekdayNumber(name string) (int, error) {
+	for i, day := range Weekdays {
+		if day == name {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday: %s", name)
+}
+
+// WeekdayName returns the day name of the given API number.
+func WeekdayName(number int) (string, error) {
+	if number < 1 || number > len(Weekdays) {
+		return "", fmt.Errorf("unknown weekday number: %d", number)
+	}
+	return Weekdays[number-1], nil
+}
+
+// MonthCycles lists the occurrences of a weekday in a month accepted by
+// nth weekday periods.
+var MonthCycles = []string{"first", "second", "third", "fourth", "last"}
+
+// ParseNthWeekday parses an nth weekday period such as "first monday" or
+// "last friday" into its month cycle and weekday.
+func ParseNthWeekday(value string) (string, string, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("expected \"<%s> <weekday>\", got: %q", strings.Join(MonthCycles, "|"), value)
+	}
+
+	cycle, day := fields[0], fields[1]
+	if !contains(MonthCycles, cycle) {
+		return "", "", fmt.Errorf("month cycle must be one of: %s, got: %s", strings.Join(MonthCycles, ", "), cycle)
+	}
+	if !contains(Weekdays, day) {
+		return "", "", fmt.Errorf("weekday must be one of: %s, got: %s", strings.Join(Weekdays, ", "), day)
+	}
+	return cycle, day, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+var timeOfDayPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$|^24:00$`)
+
+// TimeOfDayValidator validates that a string is a time of day in HH:MM
+// format, 24:00 standing for the end of the day.
+type TimeOfDayValidator struct{}
+
+func (v TimeOfDayValidator) Description(ctx context.Context) string {
+	return "value must be a time of day in HH:MM format, between 00:00 and 24:00"
+}
+
+func (v TimeOfDayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TimeOfDayValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !timeOfDayPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time of Day",
+			fmt.Sprintf("Time must be in HH:MM format, between 00:00 and 24:00, got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// WeekdaysValidator validates that every element of a set is a day name.
+type WeekdaysValidator struct{}
+
+func (v WeekdaysValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be one of: %s", strings.Join(Weekdays, ", "))
+}
+
+func (v WeekdaysValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v WeekdaysValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := WeekdayNumber(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Weekday",
+				fmt.Sprintf("Weekday must be one of: %s. Got: %s", strings.Join(Weekdays, ", "), value.ValueString()),
+			)
+		}
+	}
+}
+
+// NthWeekdayValidator validates that a string is an nth weekday period such as
+// "first monday" or "last friday".
+type NthWeekdayValidator struct{}
+
+func (v NthWeekdayValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be \"<%s> <weekday>\"", strings.Join(MonthCycles, "|"))
+}
+
+func (v NthWeekdayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v NthWeekdayValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := ParseNthWeekday(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Nth Weekday",
+			fmt.Sprintf("Invalid nth weekday: %v", err),
+		)
+	}
+}
+
+// DowntimePeriodValidator validates a recurring downtime period: exactly one
+// of weekdays, month_days or nth_weekday selects the days, end_time is after
+// start_time, days of the month exist, and flexible periods have a duration.
+type DowntimePeriodValidator struct{}
+
+func (v DowntimePeriodValidator) Description(ctx context.Context) string {
+	return "exactly one of weekdays, month_days or nth_weekday must be set, end_time must be after start_time, and duration is required when fixed is false"
+}
+
+func (v DowntimePeriodValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v DowntimePeriodValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attributes := req.ConfigValue.Attributes()
+
+	var selectors []string
+	unknown := false
+	for _, name := range []string{"weekdays", "month_days", "nth_weekday"} {
+		value, ok := attributes[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+		if value.IsUnknown() {
+			unknown = true
+		}
+		selectors = append(selectors, name)
+	}
+	switch {
+	case len(selectors) > 1:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Downtime Period",
+			fmt.Sprintf("Only one of weekdays, month_days or nth_weekday can be set, got: %s.", strings.Join(selectors, ", ")),
+		)
+	case len(selectors) == 0 && !unknown:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Downtime Period",
+			"One of weekdays, month_days or nth_weekday must be set.",
+		)
+	}
+
+	if monthDays, ok := attributes["month_days"].(types.Set); ok && !monthDays.IsNull() && !monthDays.IsUnknown() {
+		for _, element := range monthDays.Elements() {
+			day, ok := element.(types.Int64)
+			if !ok || day.IsNull() || day.IsUnknown() {
+				continue
+			}
+			if day.ValueInt64() < 1 || day.ValueInt64() > 31 {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtName("month_days").AtSetValue(day),
+					"Invalid Downtime Period",
+					fmt.Sprintf("Days of the month must be between 1 and 31, got: %d.", day.ValueInt64()),
+				)
+			}
+		}
+	}
+
+	start, startOK := knownString(attributes["start_time"])
+	end, endOK := knownString(attributes["end_time"])
+	if startOK && endOK && timeOfDayPattern.MatchString(start) && timeOfDayPattern.MatchString(end) && end <= start {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("end_time"),
+			"Invalid Downtime Period",
+			fmt.Sprintf("end_time (%s) must be after start_time (%s).", end, start),
+		)
+	}
+
+	fixed, fixedOK := attributes["fixed"].(types.Bool)
+	duration := attributes["duration"]
+	if fixedOK && !fixed.IsNull() && !fixed.IsUnknown() && !fixed.ValueBool() && (duration == nil || duration.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("duration"),
+			"Invalid Downtime Period",
+			"duration is required when fixed is false.",
+		)
+	}
+}
+
+// knownString returns the value of a known, non-null string attribute.
+func knownString(value attr.Value) (string, bool) {
+	s, ok := value.(types.String)
+	if !ok || s.IsNull() || s.IsUnknown() {
+		return "", false
+	}
+	return s.ValueString(), true
+}
@@ -0,0 +1,173 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseNthWeekday(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantCycle string
+		wantDay   string
+		wantErr   bool
+	}{
+		{value: "first monday", wantCycle: "first", wantDay: "monday"},
+		{value: "  last   sunday ", wantCycle: "last", wantDay: "sunday"},
+		{value: "fifth monday", wantErr: true},
+		{value: "first funday", wantErr: true},
+		{value: "First Monday", wantErr: true},
+		{value: "monday", wantErr: true},
+		{value: "first monday of may", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			cycle, day, err := ParseNthWeekday(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cycle != tt.wantCycle || day != tt.wantDay {
+				t.Errorf("ParseNthWeekday(%q) = %q, %q, want %q, %q", tt.value, cycle, day, tt.wantCycle, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestWeekdayNumbers(t *testing.T) {
+	for i, name := range Weekdays {
+		number, err := WeekdayNumber(name)
+		if err != nil || number != i+1 {
+			t.Errorf("WeekdayNumber(%q) = %d, %v", name, number, err)
+		}
+		back, err := WeekdayName(number)
+		if err != nil || back != name {
+			t.Errorf("WeekdayName(%d) = %q, %v", number, back, err)
+		}
+	}
+
+	if _, err := WeekdayNumber("funday"); err == nil {
+		t.Error("WeekdayNumber() accepted an unknown day")
+	}
+	for _, number := range []int{0, 8} {
+		if _, err := WeekdayName(number); err == nil {
+			t.Errorf("WeekdayName(%d) accepted an unknown day", number)
+		}
+	}
+}
+
+func TestPeriodValidators(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   func() bool
+		wantErr bool
+	}{
+		{name: "time of day", check: func() bool { return validateString(TimeOfDayValidator{}, types.StringValue("08:30")) }},
+		{name: "end of day", check: func() bool { return validateString(TimeOfDayValidator{}, types.StringValue("24:00")) }},
+		{name: "after end of day", check: func() bool { return validateString(TimeOfDayValidator{}, types.StringValue("24:01")) }, wantErr: true},
+		{name: "time without minutes", check: func() bool { return validateString(TimeOfDayValidator{}, types.StringValue("8")) }, wantErr: true},
+		{name: "nth weekday", check: func() bool { return validateString(NthWeekdayValidator{}, types.StringValue("third friday")) }},
+		{name: "invalid nth weekday", check: func() bool { return validateString(NthWeekdayValidator{}, types.StringValue("friday")) }, wantErr: true},
+		{name: "weekdays", check: func() bool { return validateSet(WeekdaysValidator{}, stringSet("monday", "sunday")) }},
+		{name: "invalid weekdays", check: func() bool { return validateSet(WeekdaysValidator{}, stringSet("monday", "mon")) }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.check(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+var downtimePeriodAttributeTypes = map[string]attr.Type{
+	"weekdays":    types.SetType{ElemType: types.StringType},
+	"month_days":  types.SetType{ElemType: types.Int64Type},
+	"nth_weekday": types.StringType,
+	"start_time":  types.StringType,
+	"end_time":    types.StringType,
+	"fixed":       types.BoolType,
+	"duration":    types.Int64Type,
+}
+
+// downtimePeriod returns a period object with the given attributes, the
+// other attributes being null.
+func downtimePeriod(values map[string]attr.Value) types.Object {
+	attributes := map[string]attr.Value{
+		"weekdays":    types.SetNull(types.StringType),
+		"month_days":  types.SetNull(types.Int64Type),
+		"nth_weekday": types.StringNull(),
+		"start_time":  types.StringValue("22:00"),
+		"end_time":    types.StringValue("23:00"),
+		"fixed":       types.BoolValue(true),
+		"duration":    types.Int64Null(),
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return types.ObjectValueMust(downtimePeriodAttributeTypes, attributes)
+}
+
+func TestDowntimePeriodValidator(t *testing.T) {
+	monthDays := func(days ...int64) types.Set {
+		elements := make([]attr.Value, len(days))
+		for i, day := range days {
+			elements[i] = types.Int64Value(day)
+		}
+		return types.SetValueMust(types.Int64Type, elements)
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]attr.Value
+		wantErr bool
+	}{
+		{name: "weekdays", values: map[string]attr.Value{"weekdays": stringSet("monday")}},
+		{name: "month days", values: map[string]attr.Value{"month_days": monthDays(1, 31)}},
+		{name: "nth weekday", values: map[string]attr.Value{"nth_weekday": types.StringValue("last friday")}},
+		{name: "unknown days", values: map[string]attr.Value{"weekdays": types.SetUnknown(types.StringType)}},
+		{name: "no days", wantErr: true},
+		{
+			name:    "several day selectors",
+			values:  map[string]attr.Value{"weekdays": stringSet("monday"), "nth_weekday": types.StringValue("last friday")},
+			wantErr: true,
+		},
+		{name: "invalid month day", values: map[string]attr.Value{"month_days": monthDays(0)}, wantErr: true},
+		{
+			name:    "end before start",
+			values:  map[string]attr.Value{"weekdays": stringSet("monday"), "end_time": types.StringValue("21:00")},
+			wantErr: true,
+		},
+		{
+			name:   "end of day",
+			values: map[string]attr.Value{"weekdays": stringSet("monday"), "end_time": types.StringValue("24:00")},
+		},
+		{
+			name:    "flexible without duration",
+			values:  map[string]attr.Value{"weekdays": stringSet("monday"), "fixed": types.BoolValue(false)},
+			wantErr: true,
+		},
+		{
+			name:   "flexible with duration",
+			values: map[string]attr.Value{"weekdays": stringSet("monday"), "fixed": types.BoolValue(false), "duration": types.Int64Value(600)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ObjectRequest{Path: path.Root("periods"), ConfigValue: downtimePeriod(tt.values)}
+			var resp validator.ObjectResponse
+			DowntimePeriodValidator{}.ValidateObject(context.Background(), req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
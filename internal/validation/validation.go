@@ -6,8 +6,10 @@ import (
 	"net"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // SNMPVersionValidator validates that SNMP version is one of: 1, 2c, or 3.
@@ -68,6 +70,62 @@ func (v HostnameOrIPValidator) ValidateString(ctx context.Context, req validator
 	}
 }
 
+// ResourceMacroNameValidator validates that a string is a resource macro
+// name enclosed in dollar signs, such as $USER1$.
+type ResourceMacroNameValidator struct{}
+
+var resourceMacroNamePattern = regexp.MustCompile(`^\$[A-Za-z0-9_-]+\$$`)
+
+func (v ResourceMacroNameValidator) Description(ctx context.Context) string {
+	return "value must be a macro name enclosed in dollar signs, such as $USER1$"
+}
+
+func (v ResourceMacroNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ResourceMacroNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !resourceMacroNamePattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Macro Name",
+			fmt.Sprintf("Macro name must be made of letters, digits, _ and - enclosed in dollar signs, such as $USER1$. Got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// OIDValidator validates that a string is a numeric SNMP OID, such as
+// .1.3.6.1.6.3.1.1.5.3.
+type OIDValidator struct{}
+
+var oidPattern = regexp.MustCompile(`^\.?[0-9]+(\.[0-9]+)+$`)
+
+func (v OIDValidator) Description(ctx context.Context) string {
+	return "value must be a numeric OID, such as .1.3.6.1.6.3.1.1.5.3"
+}
+
+func (v OIDValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v OIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !oidPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid OID",
+			fmt.Sprintf("OID must be made of numbers separated by dots, such as .1.3.6.1.6.3.1.1.5.3. Got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
 // GeoCoordsValidator validates geographic coordinates in format "lat,long".
 type GeoCoordsValidator struct{}
 
@@ -114,6 +172,32 @@ func (v GeoCoordsValidator) ValidateString(ctx context.Context, req validator.St
 	}
 }
 
+// RFC3339Validator validates that a string is a date and time in RFC 3339
+// format, such as 2024-06-01T22:00:00+02:00.
+type RFC3339Validator struct{}
+
+func (v RFC3339Validator) Description(ctx context.Context) string {
+	return "value must be a date and time in RFC 3339 format, such as 2024-06-01T22:00:00+02:00"
+}
+
+func (v RFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v RFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Date and Time",
+			fmt.Sprintf("Date and time must be in RFC 3339 format, such as 2024-06-01T22:00:00+02:00, got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
 // NotificationOptionsValidator validates notification options.
 type NotificationOptionsValidator struct{}
 
@@ -140,3 +224,414 @@ func (v NotificationOptionsValidator) ValidateInt64(ctx context.Context, req val
 		)
 	}
 }
+
+// NotificationType associates a notification type with its bit in the
+// notification options bitmask.
+type NotificationType struct {
+	Name string
+	Bit  int64
+}
+
+// HostNotificationTypes lists the notification types of hosts.
+var HostNotificationTypes = []NotificationType{
+	{Name: "down", Bit: 1},
+	{Name: "unreachable", Bit: 2},
+	{Name: "recovery", Bit: 4},
+	{Name: "flapping", Bit: 8},
+	{Name: "downtime_scheduled", Bit: 16},
+}
+
+// ServiceNotificationTypes lists the notification types of services.
+var ServiceNotificationTypes = []NotificationType{
+	{Name: "warning", Bit: 1},
+	{Name: "unknown", Bit: 2},
+	{Name: "critical", Bit: 4},
+	{Name: "recovery", Bit: 8},
+	{Name: "flapping", Bit: 16},
+	{Name: "downtime_scheduled", Bit: 32},
+}
+
+// NotificationRuleHostEvents lists the host events of notification rules.
+var NotificationRuleHostEvents = []NotificationType{
+	{Name: "up", Bit: 1},
+	{Name: "down", Bit: 2},
+	{Name: "unreachable", Bit: 4},
+}
+
+// NotificationRuleServiceEvents lists the service and business activity
+// events of notification rules.
+var NotificationRuleServiceEvents = []NotificationType{
+	{Name: "ok", Bit: 1},
+	{Name: "warning", Bit: 2},
+	{Name: "critical", Bit: 4},
+	{Name: "unknown", Bit: 8},
+}
+
+// NotificationTypesToBitmask converts notification type names to a bitmask.
+func NotificationTypesToBitmask(names []string, notificationTypes []NotificationType) (int64, error) {
+	var mask int64
+	for _, name := range names {
+		found := false
+		for _, t := range notificationTypes {
+			if t.Name == name {
+				mask |= t.Bit
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown notification type: %s", name)
+		}
+	}
+	return mask, nil
+}
+
+// BitmaskToNotificationTypes converts a bitmask to notification type names,
+// in the order of their bits.
+func BitmaskToNotificationTypes(mask int64, notificationTypes []NotificationType) []string {
+	names := []string{}
+	for _, t := range notificationTypes {
+		if mask&t.Bit != 0 {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// notificationTypeNames returns the names of the notification types.
+func notificationTypeNames(notificationTypes []NotificationType) []string {
+	names := make([]string, len(notificationTypes))
+	for i, t := range notificationTypes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// NotificationTypesValidator validates that every element of a set is one of
+// the given notification types.
+type NotificationTypesValidator struct {
+	Types []NotificationType
+}
+
+func (v NotificationTypesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be one of: %s", strings.Join(notificationTypeNames(v.Types), ", "))
+}
+
+func (v NotificationTypesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v NotificationTypesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := NotificationTypesToBitmask([]string{value.ValueString()}, v.Types); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Notification Type",
+				fmt.Sprintf("Notification type must be one of: %s. Got: %s",
+					strings.Join(notificationTypeNames(v.Types), ", "), value.ValueString()),
+			)
+		}
+	}
+}
+
+// OneOfValidator validates that a string is one of the given values.
+type OneOfValidator struct {
+	Values []string
+}
+
+func (v OneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.Values, ", "))
+}
+
+func (v OneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v OneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, value := range v.Values {
+		if req.ConfigValue.ValueString() == value {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Value",
+		fmt.Sprintf("%s must be one of: %s. Got: %s", req.Path, strings.Join(v.Values, ", "), req.ConfigValue.ValueString()),
+	)
+}
+
+// ServiceStatuses lists the service states, indexed by their code.
+var ServiceStatuses = []string{"ok", "warning", "critical", "unknown"}
+
+// ServiceStatusCode returns the code of the given service state.
+func ServiceStatusCode(name string) (int, error) {
+	for code, status := range ServiceStatuses {
+		if status == name {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown service status: %s", name)
+}
+
+// ServiceStatusName returns the name of the service state with the given code.
+func ServiceStatusName(code int) string {
+	if code < 0 || code >= len(ServiceStatuses) {
+		return ServiceStatuses[len(ServiceStatuses)-1]
+	}
+	return ServiceStatuses[code]
+}
+
+// MetaServiceCalculationTypes lists the functions aggregating the metrics of
+// a meta service.
+var MetaServiceCalculationTypes = []string{"average", "min", "max", "sum"}
+
+// DataSourceTypes lists the RRD data source types of a metric.
+var DataSourceTypes = []string{"gauge", "counter", "derive", "absolute"}
+
+// Int64BetweenValidator validates that an integer is between Min and Max, inclusive.
+type Int64BetweenValidator struct {
+	Min int64
+	Max int64
+}
+
+func (v Int64BetweenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.Min, v.Max)
+}
+
+func (v Int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v Int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.Min || value > v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Value Out of Range",
+			fmt.Sprintf("%s must be between %d and %d, got: %d", req.Path, v.Min, v.Max, value),
+		)
+	}
+}
+
+// Int64AtLeastValidator validates that an integer is greater than or equal to Min.
+type Int64AtLeastValidator struct {
+	Min int64
+}
+
+func (v Int64AtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.Min)
+}
+
+func (v Int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v Int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.Min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Value Out of Range",
+			fmt.Sprintf("%s must be at least %d, got: %d", req.Path, v.Min, value),
+		)
+	}
+}
+
+// SetSizeAtLeastValidator validates that a set has at least Min elements.
+type SetSizeAtLeastValidator struct {
+	Min int
+}
+
+func (v SetSizeAtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("set must contain at least %d elements", v.Min)
+}
+
+func (v SetSizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v SetSizeAtLeastValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	size := len(req.ConfigValue.Elements())
+	if size < v.Min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Set Size",
+			fmt.Sprintf("%s must contain at least %d elements, got: %d", req.Path, v.Min, size),
+		)
+	}
+}
+
+// FailureCriterion is a state accepted in the failure criteria of a
+// dependency, along with the code Centreon stores for it.
+type FailureCriterion struct {
+	Name string
+	Code string
+}
+
+// FailureCriterionNone disables the dependency and can't be combined with
+// other criteria.
+const FailureCriterionNone = "none"
+
+// HostFailureCriteria lists the failure criteria of host dependencies.
+var HostFailureCriteria = []FailureCriterion{
+	{Name: "up", Code: "o"},
+	{Name: "down", Code: "d"},
+	{Name: "unreachable", Code: "u"},
+	{Name: "pending", Code: "p"},
+	{Name: FailureCriterionNone, Code: "n"},
+}
+
+// HostStates lists the states of a checked host, coded by their name in the
+// real-time monitoring API.
+var HostStates = []FailureCriterion{
+	{Name: "up", Code: "UP"},
+	{Name: "down", Code: "DOWN"},
+	{Name: "unreachable", Code: "UNREACHABLE"},
+}
+
+// ServiceFailureCriteria lists the failure criteria of service dependencies.
+var ServiceFailureCriteria = []FailureCriterion{
+	{Name: "ok", Code: "o"},
+	{Name: "warning", Code: "w"},
+	{Name: "unknown", Code: "u"},
+	{Name: "critical", Code: "c"},
+	{Name: "pending", Code: "p"},
+	{Name: FailureCriterionNone, Code: "n"},
+}
+
+// HostEscalationOptions lists the host states that are escalated.
+var HostEscalationOptions = []FailureCriterion{
+	{Name: "down", Code: "d"},
+	{Name: "unreachable", Code: "u"},
+	{Name: "recovery", Code: "r"},
+}
+
+// ServiceEscalationOptions lists the service states that are escalated.
+var ServiceEscalationOptions = []FailureCriterion{
+	{Name: "warning", Code: "w"},
+	{Name: "unknown", Code: "u"},
+	{Name: "critical", Code: "c"},
+	{Name: "recovery", Code: "r"},
+}
+
+// NotificationChannels lists the channels of notification rules, coded by
+// their name in the API.
+var NotificationChannels = []FailureCriterion{
+	{Name: "email", Code: "Email"},
+	{Name: "slack", Code: "Slack"},
+	{Name: "sms", Code: "Sms"},
+}
+
+// FailureCriteriaToCodes converts failure criteria names to the
+// comma-separated codes expected by the API.
+func FailureCriteriaToCodes(names []string, criteria []FailureCriterion) (string, error) {
+	codes := make([]string, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, c := range criteria {
+			if c.Name == name {
+				codes = append(codes, c.Code)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("unknown failure criterion: %s", name)
+		}
+	}
+	return strings.Join(codes, ","), nil
+}
+
+// CodesToFailureCriteria converts comma-separated codes returned by the API
+// to failure criteria names, in the order of the criteria.
+func CodesToFailureCriteria(codes string, criteria []FailureCriterion) []string {
+	set := make(map[string]bool)
+	for _, code := range strings.Split(codes, ",") {
+		set[strings.TrimSpace(code)] = true
+	}
+
+	names := []string{}
+	for _, c := range criteria {
+		if set[c.Code] {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// failureCriterionNames returns the names of the failure criteria.
+func failureCriterionNames(criteria []FailureCriterion) []string {
+	names := make([]string, len(criteria))
+	for i, c := range criteria {
+		names[i] = c.Name
+	}
+	return names
+}
+
+// FailureCriteriaValidator validates that every element of a set is one of
+// the given failure criteria, and that "none" is not combined with others.
+type FailureCriteriaValidator struct {
+	Criteria []FailureCriterion
+}
+
+func (v FailureCriteriaValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("values must be one of: %s", strings.Join(failureCriterionNames(v.Criteria), ", "))
+}
+
+func (v FailureCriteriaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v FailureCriteriaValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	for _, element := range elements {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := FailureCriteriaToCodes([]string{value.ValueString()}, v.Criteria); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Failure Criterion",
+				fmt.Sprintf("Failure criterion must be one of: %s. Got: %s",
+					strings.Join(failureCriterionNames(v.Criteria), ", "), value.ValueString()),
+			)
+			continue
+		}
+		if value.ValueString() == FailureCriterionNone && len(elements) > 1 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Failure Criterion",
+				"The none failure criterion can't be combined with other criteria.",
+			)
+		}
+	}
+}
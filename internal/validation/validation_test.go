@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringSet returns a set of the given strings.
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// validateSet runs a set validator and reports whether it returned an error.
+func validateSet(v validator.Set, value types.Set) bool {
+	req := validator.SetRequest{Path: path.Root("test"), ConfigValue: value}
+	var resp validator.SetResponse
+	v.ValidateSet(context.Background(), req, &resp)
+	return resp.Diagnostics.HasError()
+}
+
+// validateString runs a string validator and reports whether it returned an
+// error.
+func validateString(v validator.String, value types.String) bool {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: value}
+	var resp validator.StringResponse
+	v.ValidateString(context.Background(), req, &resp)
+	return resp.Diagnostics.HasError()
+}
+
+func TestNotificationTypesToBitmask(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    int64
+		wantErr bool
+	}{
+		{name: "none", want: 0},
+		{name: "one", names: []string{"unreachable"}, want: 2},
+		{name: "several", names: []string{"down", "recovery", "downtime_scheduled"}, want: 21},
+		{name: "duplicates", names: []string{"down", "down"}, want: 1},
+		{name: "unknown", names: []string{"down", "warning"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NotificationTypesToBitmask(tt.names, HostNotificationTypes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NotificationTypesToBitmask(%v) = %d, want %d", tt.names, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBitmaskToNotificationTypes(t *testing.T) {
+	tests := []struct {
+		mask int64
+		want []string
+	}{
+		{mask: 0, want: []string{}},
+		{mask: 5, want: []string{"down", "recovery"}},
+		{mask: 31, want: []string{"down", "unreachable", "recovery", "flapping", "downtime_scheduled"}},
+		{mask: 64, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		got := BitmaskToNotificationTypes(tt.mask, HostNotificationTypes)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("BitmaskToNotificationTypes(%d) = %v, want %v", tt.mask, got, tt.want)
+		}
+	}
+
+	// Every combination of service notification types survives a round trip
+	for mask := int64(0); mask < 64; mask++ {
+		back, err := NotificationTypesToBitmask(BitmaskToNotificationTypes(mask, ServiceNotificationTypes), ServiceNotificationTypes)
+		if err != nil || back != mask {
+			t.Errorf("round trip of %d = %d, %v", mask, back, err)
+		}
+	}
+}
+
+func TestFailureCriteriaConversion(t *testing.T) {
+	codes, err := FailureCriteriaToCodes([]string{"warning", "critical"}, ServiceFailureCriteria)
+	if err != nil || codes != "w,c" {
+		t.Errorf("FailureCriteriaToCodes() = %q, %v", codes, err)
+	}
+	if _, err := FailureCriteriaToCodes([]string{"down"}, ServiceFailureCriteria); err == nil {
+		t.Error("FailureCriteriaToCodes() accepted an unknown state")
+	}
+
+	// Names are returned in the order of the options, whatever the order of
+	// the codes
+	names := CodesToFailureCriteria("c, w,x", ServiceFailureCriteria)
+	if want := []string{"warning", "critical"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("CodesToFailureCriteria() = %v, want %v", names, want)
+	}
+	if names := CodesToFailureCriteria("", ServiceFailureCriteria); len(names) != 0 {
+		t.Errorf("CodesToFailureCriteria(\"\") = %v, want no states", names)
+	}
+}
+
+func TestSetValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.Set
+		value     types.Set
+		wantErr   bool
+	}{
+		{name: "notification types", validator: NotificationTypesValidator{Types: HostNotificationTypes}, value: stringSet("down", "recovery")},
+		{name: "unknown notification type", validator: NotificationTypesValidator{Types: HostNotificationTypes}, value: stringSet("down", "warning"), wantErr: true},
+		{name: "null notification types", validator: NotificationTypesValidator{Types: HostNotificationTypes}, value: types.SetNull(types.StringType)},
+		{name: "failure criteria", validator: FailureCriteriaValidator{Criteria: ServiceFailureCriteria}, value: stringSet("ok", "critical")},
+		{name: "unknown failure criterion", validator: FailureCriteriaValidator{Criteria: ServiceFailureCriteria}, value: stringSet("down"), wantErr: true},
+		{name: "none alone", validator: FailureCriteriaValidator{Criteria: ServiceFailureCriteria}, value: stringSet(FailureCriterionNone)},
+		{name: "none combined", validator: FailureCriteriaValidator{Criteria: ServiceFailureCriteria}, value: stringSet(FailureCriterionNone, "ok"), wantErr: true},
+		{name: "size reached", validator: SetSizeAtLeastValidator{Min: 1}, value: stringSet("ok")},
+		{name: "empty", validator: SetSizeAtLeastValidator{Min: 1}, value: stringSet(), wantErr: true},
+		{name: "null size", validator: SetSizeAtLeastValidator{Min: 1}, value: types.SetNull(types.StringType)},
+		{name: "unknown size", validator: SetSizeAtLeastValidator{Min: 1}, value: types.SetUnknown(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateSet(tt.validator, tt.value); got != tt.wantErr {
+				t.Errorf("error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStringValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   bool
+	}{
+		{name: "RFC 3339 with offset", validator: RFC3339Validator{}, value: types.StringValue("2024-06-01T22:00:00+02:00")},
+		{name: "RFC 3339 in UTC", validator: RFC3339Validator{}, value: types.StringValue("2024-06-01T20:00:00Z")},
+		{name: "date only", validator: RFC3339Validator{}, value: types.StringValue("2024-06-01"), wantErr: true},
+		{name: "null date", validator: RFC3339Validator{}, value: types.StringNull()},
+		{name: "one of", validator: OneOfValidator{Values: []string{"a", "b"}}, value: types.StringValue("b")},
+		{name: "not one of", validator: OneOfValidator{Values: []string{"a", "b"}}, value: types.StringValue("c"), wantErr: true},
+		{name: "SNMP version", validator: SNMPVersionValidator{}, value: types.StringValue("2c")},
+		{name: "invalid SNMP version", validator: SNMPVersionValidator{}, value: types.StringValue("4"), wantErr: true},
+		{name: "geographic coordinates", validator: GeoCoordsValidator{}, value: types.StringValue("48.85,2.35")},
+		{name: "invalid latitude", validator: GeoCoordsValidator{}, value: types.StringValue("91,2.35"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateString(tt.validator, tt.value); got != tt.wantErr {
+				t.Errorf("error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInt64Validators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.Int64
+		value     types.Int64
+		wantErr   bool
+	}{
+		{name: "between", validator: Int64BetweenValidator{Min: 1, Max: 5}, value: types.Int64Value(5)},
+		{name: "above", validator: Int64BetweenValidator{Min: 1, Max: 5}, value: types.Int64Value(6), wantErr: true},
+		{name: "at least", validator: Int64AtLeastValidator{Min: 1}, value: types.Int64Value(1)},
+		{name: "below", validator: Int64AtLeastValidator{Min: 1}, value: types.Int64Value(0), wantErr: true},
+		{name: "unknown", validator: Int64AtLeastValidator{Min: 1}, value: types.Int64Unknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root("test"), ConfigValue: tt.value}
+			var resp validator.Int64Response
+			tt.validator.ValidateInt64(context.Background(), req, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}